@@ -0,0 +1,281 @@
+package polygfgo
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+	"strings"
+)
+
+// BigPolynomial - многочлен с коэффициентами произвольной длины.
+// Как и в Polynomial, коэффициенты хранятся от младшего к старшему.
+type BigPolynomial struct {
+	coefs []*big.Int
+	deg   int
+}
+
+// NewBigPolynomial создаёт многочлен; коэффициенты передаются начиная со старшего.
+func NewBigPolynomial(coefs []*big.Int) BigPolynomial {
+	result := make([]*big.Int, len(coefs))
+	for i, c := range coefs {
+		result[len(coefs)-1-i] = new(big.Int).Set(c)
+	}
+	return newBigPolynomialNoReverse(result)
+}
+
+// newBigPolynomialNoReverse не копирует коэффициенты, а только отбрасывает старшие нули.
+func newBigPolynomialNoReverse(coefs []*big.Int) BigPolynomial {
+	i := len(coefs) - 1
+	for ; i >= 0; i-- {
+		if coefs[i].Sign() != 0 {
+			break
+		}
+	}
+	return BigPolynomial{coefs[:i+1], i}
+}
+
+func newZeroBigPolynomial() BigPolynomial {
+	return BigPolynomial{[]*big.Int{}, -1}
+}
+
+func (p BigPolynomial) GetDegree() int {
+	return p.deg
+}
+
+func (p BigPolynomial) isZeroPolynomial() bool {
+	return p.deg == -1
+}
+
+// Coefficient возвращает копию коэффициента при x^i.
+func (p BigPolynomial) Coefficient(i int) *big.Int {
+	if i < 0 || i > p.deg {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(p.coefs[i])
+}
+
+func (p BigPolynomial) Equals(q BigPolynomial) bool {
+	if p.deg != q.deg {
+		return false
+	}
+	for i := 0; i <= p.deg; i++ {
+		if p.coefs[i].Cmp(q.coefs[i]) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (p BigPolynomial) ToString() string {
+	parts := make([]string, 0, p.deg+1)
+	for i := p.deg; i >= 0; i-- {
+		parts = append(parts, p.coefs[i].String())
+	}
+	return "[" + strings.Join(parts, " ") + "]"
+}
+
+// Представление простого поля GF(p) для p произвольной длины (например, 256 бит и более).
+// BigField - самостоятельный тип и не реализует FieldInterface: характеристика и коэффициенты
+// хранятся в *big.Int, поэтому с Element, FieldPolynomial и Matrix он не используется.
+// Поддерживаются GetPrime, GetDegree, Normalize, Add/Sub/Mul/DivPolynomials, Inv, InvPolynomial,
+// PowModPolynomial, GCD, IsIrreducible и ToString над многочленами BigPolynomial.
+type BigField struct {
+	p             *big.Int
+	enableLogging bool
+}
+
+// NewBigField создаёт поле GF(p), предварительно проверяя p на простоту.
+func NewBigField(p *big.Int, enableLogging bool) (BigField, error) {
+	if p.Cmp(big.NewInt(2)) < 0 || !p.ProbablyPrime(32) {
+		err := fmt.Errorf("%w: p=%s", ErrNotPrime, p.String())
+		tryLog(enableLogging, err)
+		return BigField{}, err
+	}
+	return BigField{new(big.Int).Set(p), enableLogging}, nil
+}
+
+func (f BigField) GetPrime() *big.Int {
+	return new(big.Int).Set(f.p)
+}
+
+func (f BigField) GetDegree() int {
+	return 1
+}
+
+func (f BigField) Normalize(poly BigPolynomial) BigPolynomial {
+	coefs := make([]*big.Int, poly.deg+1)
+	for i := range coefs {
+		coefs[i] = new(big.Int).Mod(poly.coefs[i], f.p)
+	}
+	return newBigPolynomialNoReverse(coefs)
+}
+
+func (f BigField) AddPolynomials(p1, p2 BigPolynomial) BigPolynomial {
+	coefs := make([]*big.Int, max(p1.deg, p2.deg)+1)
+	for i := range coefs {
+		coefs[i] = new(big.Int).Add(p1.Coefficient(i), p2.Coefficient(i))
+		coefs[i].Mod(coefs[i], f.p)
+	}
+	return newBigPolynomialNoReverse(coefs)
+}
+
+func (f BigField) SubPolynomials(p1, p2 BigPolynomial) BigPolynomial {
+	coefs := make([]*big.Int, max(p1.deg, p2.deg)+1)
+	for i := range coefs {
+		coefs[i] = new(big.Int).Sub(p1.Coefficient(i), p2.Coefficient(i))
+		coefs[i].Mod(coefs[i], f.p)
+	}
+	return newBigPolynomialNoReverse(coefs)
+}
+
+// MulPolynomials умножает многочлены подстановкой Кронекера: коэффициенты
+// упаковываются в одно большое число, умножение которого math/big выполняет
+// быстрыми алгоритмами, после чего результат распаковывается обратно.
+func (f BigField) MulPolynomials(p1, p2 BigPolynomial) BigPolynomial {
+	p1, p2 = f.Normalize(p1), f.Normalize(p2)
+	if p1.isZeroPolynomial() || p2.isZeroPolynomial() {
+		return newZeroBigPolynomial()
+	}
+
+	// Коэффициенты произведения меньше n*p^2, выравниваем слоты по границе слова
+	n := min(p1.deg, p2.deg) + 1
+	slotBits := 2*f.p.BitLen() + bits.Len(uint(n)) + 1
+	slotWords := (slotBits + bits.UintSize - 1) / bits.UintSize
+
+	product := new(big.Int).Mul(packBigPolynomial(p1, slotWords), packBigPolynomial(p2, slotWords))
+	words := product.Bits()
+
+	coefs := make([]*big.Int, p1.deg+p2.deg+1)
+	for i := range coefs {
+		start := min(i*slotWords, len(words))
+		end := min(start+slotWords, len(words))
+		slot := make([]big.Word, end-start)
+		copy(slot, words[start:end])
+		coefs[i] = new(big.Int).SetBits(slot)
+		coefs[i].Mod(coefs[i], f.p)
+	}
+	return newBigPolynomialNoReverse(coefs)
+}
+
+func packBigPolynomial(poly BigPolynomial, slotWords int) *big.Int {
+	words := make([]big.Word, (poly.deg+1)*slotWords)
+	for i, c := range poly.coefs {
+		copy(words[i*slotWords:], c.Bits())
+	}
+	return new(big.Int).SetBits(words)
+}
+
+func (f BigField) DivPolynomials(p1, p2 BigPolynomial) (quot, rem BigPolynomial, err error) {
+	p1, p2 = f.Normalize(p1), f.Normalize(p2)
+	if p2.isZeroPolynomial() {
+		err = fmt.Errorf("division by zero is not supported")
+		tryLog(f.enableLogging, err)
+		return newZeroBigPolynomial(), newZeroBigPolynomial(), err
+	}
+	if p1.deg < p2.deg {
+		return newZeroBigPolynomial(), p1, nil
+	}
+
+	inv, err := f.Inv(p2.coefs[p2.deg])
+	if err != nil {
+		return newZeroBigPolynomial(), newZeroBigPolynomial(), err
+	}
+
+	r := p1.coefs
+	q := make([]*big.Int, p1.deg-p2.deg+1)
+	tmp := new(big.Int)
+	for k := p1.deg - p2.deg; k >= 0; k-- {
+		lead := new(big.Int).Mul(r[k+p2.deg], inv)
+		lead.Mod(lead, f.p)
+		q[k] = lead
+		if lead.Sign() == 0 {
+			continue
+		}
+		for i, d := range p2.coefs {
+			tmp.Mul(lead, d)
+			r[k+i].Sub(r[k+i], tmp)
+			r[k+i].Mod(r[k+i], f.p)
+		}
+	}
+
+	quot = newBigPolynomialNoReverse(q)
+	rem = newBigPolynomialNoReverse(r[:p2.deg])
+	return
+}
+
+// Inv возвращает обратный к a элемент GF(p), вычисленный расширенным алгоритмом Евклида.
+func (f BigField) Inv(a *big.Int) (*big.Int, error) {
+	inv := new(big.Int).ModInverse(new(big.Int).Mod(a, f.p), f.p)
+	if inv == nil {
+		err := fmt.Errorf("there is no reverse element")
+		tryLog(f.enableLogging, err)
+		return nil, err
+	}
+	return inv, nil
+}
+
+// InvPolynomial возвращает обратный элемент GF(p) для многочлена нулевой степени.
+func (f BigField) InvPolynomial(poly BigPolynomial) (BigPolynomial, error) {
+	poly = f.Normalize(poly)
+	if poly.deg != 0 {
+		err := fmt.Errorf("polynomial %s is not a nonzero element of %s", poly.ToString(), f.ToString())
+		tryLog(f.enableLogging, err)
+		return newZeroBigPolynomial(), err
+	}
+	inv, err := f.Inv(poly.coefs[0])
+	if err != nil {
+		return newZeroBigPolynomial(), err
+	}
+	return BigPolynomial{[]*big.Int{inv}, 0}, nil
+}
+
+// PowModPolynomial вычисляет base^exp mod mod.
+func (f BigField) PowModPolynomial(base BigPolynomial, exp *big.Int, mod BigPolynomial) BigPolynomial {
+	result := BigPolynomial{[]*big.Int{big.NewInt(1)}, 0}
+	_, current, _ := f.DivPolynomials(base, mod)
+
+	for i := 0; i < exp.BitLen(); i++ {
+		if exp.Bit(i) == 1 {
+			_, result, _ = f.DivPolynomials(f.MulPolynomials(result, current), mod)
+		}
+		_, current, _ = f.DivPolynomials(f.MulPolynomials(current, current), mod)
+	}
+
+	_, result, _ = f.DivPolynomials(result, mod)
+	return result
+}
+
+func (f BigField) GCD(p1, p2 BigPolynomial) BigPolynomial {
+	p1, p2 = f.Normalize(p1), f.Normalize(p2)
+	for !p2.isZeroPolynomial() {
+		_, mod, _ := f.DivPolynomials(p1, p2)
+		p1, p2 = p2, mod
+	}
+	return p1
+}
+
+// IsIrreducible проверяет, что gcd(poly, x^(p^i) - x) = 1 для всех i <= deg/2.
+func (f BigField) IsIrreducible(poly BigPolynomial) bool {
+	poly = f.Normalize(poly)
+	n := poly.deg
+	if n < 1 {
+		return false
+	}
+	if n == 1 {
+		return true
+	}
+
+	x := newBigPolynomialNoReverse([]*big.Int{big.NewInt(0), big.NewInt(1)})
+	h := x
+	for i := 1; i <= n/2; i++ {
+		h = f.PowModPolynomial(h, f.p, poly)
+		if f.GCD(poly, f.SubPolynomials(h, x)).deg > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (f BigField) ToString() string {
+	return fmt.Sprintf("GF(%s)", f.p.String())
+}
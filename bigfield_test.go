@@ -0,0 +1,124 @@
+package polygfgo
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func bigInts(values ...string) []*big.Int {
+	result := make([]*big.Int, len(values))
+	for i, v := range values {
+		result[i], _ = new(big.Int).SetString(v, 0)
+	}
+	return result
+}
+
+// GF(p) для p = 2^256 - 2^32 - 977 (secp256k1)
+func newSecp256k1Field() BigField {
+	p, _ := new(big.Int).SetString("0xFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEFFFFFC2F", 0)
+	f, _ := NewBigField(p, false)
+	return f
+}
+
+func TestNewBigField(t *testing.T) {
+	t.Run("composite modulus is rejected", func(t *testing.T) {
+		p, _ := new(big.Int).SetString("0xFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEFFFFFC2D", 0)
+
+		_, err := NewBigField(p, false)
+		if !errors.Is(err, ErrNotPrime) {
+			t.Errorf("Expected %v but got %v", ErrNotPrime, err)
+		}
+	})
+}
+
+func TestBigField_Inv(t *testing.T) {
+	f := newSecp256k1Field()
+
+	t.Run("inverse of a 256-bit element", func(t *testing.T) {
+		a := bigInts("0x79BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798")[0]
+
+		inv, err := f.Inv(a)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		got := new(big.Int).Mul(a, inv)
+		got.Mod(got, f.GetPrime())
+
+		if got.Cmp(big.NewInt(1)) != 0 {
+			t.Errorf("Expected 1 but got %s", got.String())
+		}
+	})
+
+	t.Run("zero has no inverse", func(t *testing.T) {
+		if _, err := f.Inv(f.GetPrime()); err == nil {
+			t.Errorf("Expected error for inverse of zero")
+		}
+	})
+}
+
+func TestBigField_Polynomials(t *testing.T) {
+	f := newSecp256k1Field()
+
+	t.Run("multiplication reduces coefficients modulo p", func(t *testing.T) {
+		// (x - 1)(x + 1) = x^2 - 1
+		p1 := NewBigPolynomial(bigInts("1", "-1"))
+		p2 := NewBigPolynomial(bigInts("1", "1"))
+
+		got := f.MulPolynomials(p1, p2)
+		want := f.Normalize(NewBigPolynomial(bigInts("1", "0", "-1")))
+
+		if !got.Equals(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+	})
+
+	t.Run("division with remainder", func(t *testing.T) {
+		a := NewBigPolynomial(bigInts("0x1234567890ABCDEF1234567890ABCDEF", "7", "0", "0xDEADBEEF", "-5"))
+		b := NewBigPolynomial(bigInts("3", "0x55555555555555555555555555", "11"))
+
+		quot, rem, err := f.DivPolynomials(a, b)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		got := f.AddPolynomials(f.MulPolynomials(quot, b), rem)
+		want := f.Normalize(a)
+
+		if !got.Equals(want) || rem.GetDegree() >= b.GetDegree() {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+	})
+
+	t.Run("GCD of polynomials with a common factor", func(t *testing.T) {
+		common := NewBigPolynomial(bigInts("1", "0x10001"))
+		p1 := f.MulPolynomials(common, NewBigPolynomial(bigInts("1", "2")))
+		p2 := f.MulPolynomials(common, NewBigPolynomial(bigInts("1", "0", "3")))
+
+		got := f.GCD(p1, p2)
+
+		if got.GetDegree() != 1 {
+			t.Errorf("Expected GCD of degree 1 but got %s", got.ToString())
+		}
+		if _, rem, _ := f.DivPolynomials(common, got); !rem.isZeroPolynomial() {
+			t.Errorf("GCD %s does not divide %s", got.ToString(), common.ToString())
+		}
+	})
+
+	t.Run("x^2 + 1 is irreducible since p = 3 mod 4", func(t *testing.T) {
+		got := f.IsIrreducible(NewBigPolynomial(bigInts("1", "0", "1")))
+		want := true
+
+		if got != want {
+			t.Errorf("Expected %t but got %t", want, got)
+		}
+	})
+
+	t.Run("x^2 - 4 is reducible", func(t *testing.T) {
+		got := f.IsIrreducible(NewBigPolynomial(bigInts("1", "0", "-4")))
+		want := false
+
+		if got != want {
+			t.Errorf("Expected %t but got %t", want, got)
+		}
+	})
+}
@@ -0,0 +1,363 @@
+package polygfgo
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// BinaryElement - элемент GF(2^m), упакованный в машинные слова:
+// i-й бит (бит i%64 слова i/64) - коэффициент при x^i.
+type BinaryElement []uint64
+
+func (e BinaryElement) IsZero() bool {
+	for _, w := range e {
+		if w != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (e BinaryElement) Equals(other BinaryElement) bool {
+	if len(e) != len(other) {
+		return false
+	}
+	for i := range e {
+		if e[i] != other[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Представление поля GF(2^m) с упакованными элементами: сложение - XOR,
+// умножение - умножение без переносов с быстрым приведением по разреженному генератору.
+type BinaryField struct {
+	m             int
+	words         int
+	generator     Polynomial
+	terms         []int // показатели ненулевых членов генератора, кроме старшего, по убыванию
+	enableLogging bool
+}
+
+// NewBinaryField создаёт поле GF(2^m), где m - степень порождающего многочлена.
+// Генератор должен быть неприводим над GF(2); проверка выполняется в упакованном виде,
+// поэтому допустимы степени порядка сотен (например, 571 для бинарных кривых NIST).
+func NewBinaryField(generator Polynomial, enableLogging bool) (field BinaryField, err error) {
+	generator = SimpleField{2, enableLogging}.Normalize(generator)
+	if generator.deg < 1 {
+		err = fmt.Errorf("%w: generator %s must have positive degree", ErrGeneratorDegree, generator.ToString())
+		tryLog(enableLogging, err)
+		return
+	}
+
+	terms := []int{}
+	for i := generator.deg - 1; i >= 0; i-- {
+		if generator.coefs[i] == 1 {
+			terms = append(terms, i)
+		}
+	}
+	field = BinaryField{generator.deg, (generator.deg + 63) / 64, generator, terms, enableLogging}
+
+	if !field.IsIrreducible(generator) {
+		err = fmt.Errorf("%w: %s over GF(2)", ErrGeneratorReducible, generator.ToString())
+		tryLog(enableLogging, err)
+		return BinaryField{}, err
+	}
+	return
+}
+
+func (f BinaryField) GetPrime() int {
+	return 2
+}
+
+func (f BinaryField) GetDegree() int {
+	return f.m
+}
+
+func (f BinaryField) GetIrreducible() Polynomial {
+	return f.generator
+}
+
+// Pack упаковывает многочлен над GF(2) в элемент поля, приводя его по модулю генератора.
+func (f BinaryField) Pack(poly Polynomial) BinaryElement {
+	packed := packBinary(poly)
+	return f.reduce(packed)
+}
+
+// Unpack возвращает многочлен, соответствующий элементу.
+func (f BinaryField) Unpack(e BinaryElement) Polynomial {
+	coefs := make([]int, len(e)*64)
+	for i := range coefs {
+		coefs[i] = int(e[i/64] >> (i % 64) & 1)
+	}
+	return newPolynomialNoReverse(coefs)
+}
+
+// Add, Mul и Square принимают элементы любой длины: короткие дополняются нулями,
+// длинные и неприведённые приводятся по модулю генератора, как в Pack.
+func (f BinaryField) Add(a, b BinaryElement) BinaryElement {
+	a, b = f.fit(a), f.fit(b)
+	result := make(BinaryElement, f.words)
+	for i := range result {
+		result[i] = a[i] ^ b[i]
+	}
+	return result
+}
+
+func (f BinaryField) Mul(a, b BinaryElement) BinaryElement {
+	a, b = f.fit(a), f.fit(b)
+	product := make([]uint64, 2*f.words)
+	for i, x := range a {
+		if x == 0 {
+			continue
+		}
+		for j, y := range b {
+			hi, lo := clmul64(x, y)
+			product[i+j] ^= lo
+			product[i+j+1] ^= hi
+		}
+	}
+	return f.reduce(product)
+}
+
+// Square возводит в квадрат: в характеристике 2 это раздвижка бит нулями.
+func (f BinaryField) Square(a BinaryElement) BinaryElement {
+	a = f.fit(a)
+	product := make([]uint64, 2*f.words)
+	for i, x := range a {
+		product[2*i] = spreadBits(uint32(x))
+		product[2*i+1] = spreadBits(uint32(x >> 32))
+	}
+	return f.reduce(product)
+}
+
+// Inv вычисляет a^(2^m - 2) по схеме Итоха-Цудзии: m-1 возведений в квадрат
+// и O(log m) умножений.
+func (f BinaryField) Inv(a BinaryElement) (BinaryElement, error) {
+	if a.IsZero() {
+		err := fmt.Errorf("zero element of %s has no inverse", f.ToString())
+		tryLog(f.enableLogging, err)
+		return nil, err
+	}
+	if f.m == 1 {
+		return f.reduce(a), nil
+	}
+
+	// beta = a^(2^k - 1), собираем k = m - 1 по битам начиная со старшего
+	n := f.m - 1
+	beta := f.reduce(a)
+	k := 1
+	for i := bits.Len(uint(n)) - 2; i >= 0; i-- {
+		t := beta
+		for j := 0; j < k; j++ {
+			t = f.Square(t)
+		}
+		beta = f.Mul(t, beta)
+		k *= 2
+		if n>>i&1 == 1 {
+			beta = f.Mul(f.Square(beta), a)
+			k++
+		}
+	}
+	return f.Square(beta), nil
+}
+
+// reduce приводит упакованный многочлен по модулю генератора.
+// Старшие слова обрабатываются целиком: каждый бит x^j при j >= m заменяется
+// суммой x^(j-m+e) по членам генератора.
+func (f BinaryField) reduce(r []uint64) BinaryElement {
+	r = append([]uint64{}, r...)
+	top := f.m / 64
+	for i := len(r) - 1; i >= top; i-- {
+		for {
+			t := r[i]
+			if i == top {
+				t = t >> (f.m % 64) << (f.m % 64)
+			}
+			if t == 0 {
+				break
+			}
+			r[i] ^= t
+			for _, e := range f.terms {
+				xorShiftedWord(r, t, i*64-f.m+e)
+			}
+		}
+	}
+
+	result := make(BinaryElement, f.words)
+	copy(result, r)
+	return result
+}
+
+// fit возвращает элемент как есть, если он уже занимает f.words слов и приведён,
+// иначе приводит его через reduce.
+func (f BinaryField) fit(e BinaryElement) BinaryElement {
+	if len(e) == f.words && (f.m%64 == 0 || e[f.words-1]>>(f.m%64) == 0) {
+		return e
+	}
+	return f.reduce(e)
+}
+
+// xorShiftedWord прибавляет к r слово t, сдвинутое на off бит (off может быть отрицательным,
+// если младшие биты t заведомо нулевые).
+func xorShiftedWord(r []uint64, t uint64, off int) {
+	if off < 0 {
+		r[0] ^= t >> -off
+		return
+	}
+	w, b := off/64, off%64
+	if w < len(r) {
+		r[w] ^= t << b
+	}
+	if b != 0 && w+1 < len(r) {
+		r[w+1] ^= t >> (64 - b)
+	}
+}
+
+// clmul64 - умножение без переносов двух 64-битных многочленов над GF(2)
+// методом 4-битного окна; три старших бита a учитываются отдельно.
+func clmul64(a, b uint64) (hi, lo uint64) {
+	var tab [16]uint64
+	a0 := a & (1<<61 - 1)
+	for i := 1; i < 16; i++ {
+		tab[i] = tab[i>>1] << 1
+		if i&1 == 1 {
+			tab[i] ^= a0
+		}
+	}
+
+	for s := 60; s >= 0; s -= 4 {
+		t := tab[b>>s&15]
+		lo ^= t << s
+		if s > 0 {
+			hi ^= t >> (64 - s)
+		}
+	}
+
+	for j := 61; j < 64; j++ {
+		if a>>j&1 == 1 {
+			lo ^= b << j
+			hi ^= b >> (64 - j)
+		}
+	}
+	return
+}
+
+// spreadBits вставляет нулевой бит после каждого бита x.
+func spreadBits(x uint32) uint64 {
+	v := uint64(x)
+	v = (v | v<<16) & 0x0000FFFF0000FFFF
+	v = (v | v<<8) & 0x00FF00FF00FF00FF
+	v = (v | v<<4) & 0x0F0F0F0F0F0F0F0F
+	v = (v | v<<2) & 0x3333333333333333
+	v = (v | v<<1) & 0x5555555555555555
+	return v
+}
+
+func packBinary(poly Polynomial) []uint64 {
+	packed := make([]uint64, (poly.len+63)/64)
+	for i := 0; i < poly.len; i++ {
+		if reduceInt(poly.coefs[i], 2) == 1 {
+			packed[i/64] |= 1 << (i % 64)
+		}
+	}
+	return packed
+}
+
+func (f BinaryField) AddPolynomials(p1, p2 Polynomial) Polynomial {
+	return f.Unpack(f.Add(f.Pack(p1), f.Pack(p2)))
+}
+
+func (f BinaryField) SubPolynomials(p1, p2 Polynomial) Polynomial {
+	return f.AddPolynomials(p1, p2)
+}
+
+func (f BinaryField) MulPolynomials(p1, p2 Polynomial) Polynomial {
+	return f.Unpack(f.Mul(f.Pack(p1), f.Pack(p2)))
+}
+
+// DivPolynomials, как и в ExtendedField, возвращает нулевое частное и p1 * p2^(-1) в качестве остатка.
+func (f BinaryField) DivPolynomials(p1, p2 Polynomial) (Polynomial, Polynomial, error) {
+	inverse, err := f.Inv(f.Pack(p2))
+	if err != nil {
+		return newZeroPolynomial(), newZeroPolynomial(), err
+	}
+	return newZeroPolynomial(), f.Unpack(f.Mul(f.Pack(p1), inverse)), nil
+}
+
+func (f BinaryField) InvPolynomial(poly Polynomial) (Polynomial, error) {
+	inverse, err := f.Inv(f.Pack(poly))
+	if err != nil {
+		return newZeroPolynomial(), err
+	}
+	return f.Unpack(inverse), nil
+}
+
+// IsIrreducible проверяет неприводимость многочлена над GF(2) тестом Бен-Ора:
+// gcd(poly, x^(2^i) - x) = 1 для всех i <= deg/2. Вычисления ведутся в упакованном виде.
+func (f BinaryField) IsIrreducible(poly Polynomial) bool {
+	g := packBinary(poly)
+	n := binaryDegree(g)
+	if n < 1 {
+		return false
+	}
+	if n == 1 {
+		return true
+	}
+
+	// Арифметика в кольце GF(2)[x]/(poly) при том же алгоритме приведения
+	ring := BinaryField{m: n, words: (n + 63) / 64}
+	for i := n - 1; i >= 0; i-- {
+		if g[i/64]>>(i%64)&1 == 1 {
+			ring.terms = append(ring.terms, i)
+		}
+	}
+
+	x := ring.reduce([]uint64{2})
+	h := x
+	for i := 1; i <= n/2; i++ {
+		h = ring.Square(h)
+		if binaryDegree(binaryGCD(g, ring.Add(h, x))) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (f BinaryField) GCD(p1, p2 Polynomial) Polynomial {
+	return f.Unpack(binaryGCD(packBinary(p1), packBinary(p2)))
+}
+
+func (f BinaryField) ToString() string {
+	return fmt.Sprintf("GF(%d^%d) mod %s", 2, f.m, f.generator.ToString())
+}
+
+// binaryDegree возвращает степень упакованного многочлена (-1 для нуля).
+func binaryDegree(a []uint64) int {
+	for i := len(a) - 1; i >= 0; i-- {
+		if a[i] != 0 {
+			return i*64 + bits.Len64(a[i]) - 1
+		}
+	}
+	return -1
+}
+
+// binaryGCD вычисляет НОД упакованных многочленов над GF(2) алгоритмом Евклида.
+func binaryGCD(a, b []uint64) []uint64 {
+	a = append([]uint64{}, a...)
+	b = append([]uint64{}, b...)
+	for binaryDegree(b) >= 0 {
+		db := binaryDegree(b)
+		for da := binaryDegree(a); da >= db; da = binaryDegree(a) {
+			shift := da - db
+			for i := len(b) - 1; i >= 0; i-- {
+				if b[i] != 0 {
+					xorShiftedWord(a, b[i], i*64+shift)
+				}
+			}
+		}
+		a, b = b, a
+	}
+	return a
+}
@@ -0,0 +1,184 @@
+package polygfgo
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+// binaryGenerator строит многочлен над GF(2) по показателям ненулевых членов.
+func binaryGenerator(exponents ...int) Polynomial {
+	coefs := make([]int, exponents[0]+1)
+	for _, e := range exponents {
+		coefs[e] = 1
+	}
+	return newPolynomialNoReverse(coefs)
+}
+
+func randomBinaryElement(rng *rand.Rand, f BinaryField) BinaryElement {
+	coefs := make([]int, f.GetDegree())
+	for i := range coefs {
+		coefs[i] = rng.Intn(2)
+	}
+	return f.Pack(newPolynomialNoReverse(coefs))
+}
+
+func TestClmul64(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		a, b := rng.Uint64(), rng.Uint64()
+		if i == 0 {
+			a, b = ^uint64(0), ^uint64(0)
+		}
+
+		var wantHi, wantLo uint64
+		for j := 0; j < 64; j++ {
+			if b>>j&1 == 1 {
+				wantLo ^= a << j
+				if j > 0 {
+					wantHi ^= a >> (64 - j)
+				}
+			}
+		}
+
+		gotHi, gotLo := clmul64(a, b)
+		if gotHi != wantHi || gotLo != wantLo {
+			t.Fatalf("Expected %x:%x but got %x:%x for %x * %x", wantHi, wantLo, gotHi, gotLo, a, b)
+		}
+	}
+}
+
+func TestBinaryField_MatchesExtendedField(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	generators := []Polynomial{
+		binaryGenerator(8, 4, 3, 1, 0),
+		binaryGenerator(13, 4, 3, 1, 0),
+	}
+
+	for _, g := range generators {
+		bf, err := NewBinaryField(g, false)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		ef := ExtendedField{SimpleField{2, false}, 2, g.deg, g, false}
+
+		t.Run(bf.ToString(), func(t *testing.T) {
+			for i := 0; i < 200; i++ {
+				a, b := randomBinaryElement(rng, bf), randomBinaryElement(rng, bf)
+
+				got := bf.Unpack(bf.Mul(a, b))
+				want := ef.MulPolynomials(bf.Unpack(a), bf.Unpack(b))
+
+				if !got.Equals(want) {
+					t.Fatalf("Expected %s but got %s", want.ToString(), got.ToString())
+				}
+			}
+		})
+	}
+}
+
+func TestBinaryField_Arithmetic(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	generators := []Polynomial{
+		binaryGenerator(64, 4, 3, 1, 0),
+		binaryGenerator(127, 1, 0),
+		binaryGenerator(163, 7, 6, 3, 0),
+		binaryGenerator(571, 10, 5, 2, 0),
+	}
+
+	for _, g := range generators {
+		f, err := NewBinaryField(g, false)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		t.Run(f.ToString(), func(t *testing.T) {
+			for i := 0; i < 20; i++ {
+				a, b, c := randomBinaryElement(rng, f), randomBinaryElement(rng, f), randomBinaryElement(rng, f)
+
+				if !f.Mul(f.Mul(a, b), c).Equals(f.Mul(a, f.Mul(b, c))) {
+					t.Fatalf("Multiplication is not associative")
+				}
+				if !f.Mul(a, f.Add(b, c)).Equals(f.Add(f.Mul(a, b), f.Mul(a, c))) {
+					t.Fatalf("Multiplication is not distributive")
+				}
+				if !f.Square(a).Equals(f.Mul(a, a)) {
+					t.Fatalf("Square differs from multiplication")
+				}
+
+				inv, err := f.Inv(a)
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				if got := f.Unpack(f.Mul(a, inv)); !got.Equals(newPolynomialNoReverse([]int{1})) {
+					t.Fatalf("Wrong inverse: a * a^-1 = %s", got.ToString())
+				}
+			}
+		})
+	}
+}
+
+func TestBinaryField_UnpaddedElements(t *testing.T) {
+	f, _ := NewBinaryField(binaryGenerator(163, 7, 6, 3, 0), false)
+	a := f.Pack(NewPolynomial([]int{1, 0}))
+
+	t.Run("short elements are padded", func(t *testing.T) {
+		want := f.Pack(NewPolynomial([]int{1, 1, 0}))
+		if got := f.Add(BinaryElement{4}, a); !got.Equals(want) {
+			t.Errorf("Expected %s but got %s", f.Unpack(want).ToString(), f.Unpack(got).ToString())
+		}
+		if got := f.Square(BinaryElement{2}); !got.Equals(f.Mul(a, a)) {
+			t.Errorf("Expected %s but got %s", f.Unpack(f.Mul(a, a)).ToString(), f.Unpack(got).ToString())
+		}
+	})
+
+	t.Run("long elements are reduced", func(t *testing.T) {
+		long := f.Pack(NewPolynomial([]int{1}))
+		long = append(long, 0, 0, 0, 1)
+		want := f.Pack(f.Unpack(long))
+		if got := f.Mul(long, a); !got.Equals(f.Mul(want, a)) {
+			t.Errorf("Expected %s but got %s", f.Unpack(f.Mul(want, a)).ToString(), f.Unpack(got).ToString())
+		}
+	})
+}
+
+func TestNewBinaryField(t *testing.T) {
+	t.Run("reducible generator", func(t *testing.T) {
+		_, err := NewBinaryField(binaryGenerator(8, 2, 0), false)
+		if !errors.Is(err, ErrGeneratorReducible) {
+			t.Errorf("Expected %v but got %v", ErrGeneratorReducible, err)
+		}
+	})
+
+	t.Run("constant generator", func(t *testing.T) {
+		_, err := NewBinaryField(binaryGenerator(0), false)
+		if !errors.Is(err, ErrGeneratorDegree) {
+			t.Errorf("Expected %v but got %v", ErrGeneratorDegree, err)
+		}
+	})
+
+	t.Run("AES inverse through FieldInterface", func(t *testing.T) {
+		f, _ := NewBinaryField(binaryGenerator(8, 4, 3, 1, 0), false)
+		a := byteElement(f, 0x53)
+
+		got, err := a.Inv()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := byteElement(f, 0xCA)
+
+		if !got.Equal(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+	})
+}
+
+func BenchmarkBinaryField_Mul571(b *testing.B) {
+	rng := rand.New(rand.NewSource(4))
+	f, _ := NewBinaryField(binaryGenerator(571, 10, 5, 2, 0), false)
+	x, y := randomBinaryElement(rng, f), randomBinaryElement(rng, f)
+
+	for i := 0; i < b.N; i++ {
+		f.Mul(x, y)
+	}
+}
@@ -0,0 +1,167 @@
+package polygfgo
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"time"
+)
+
+// equalDegreeAttempts - число случайных многочленов, после которого расщепление множителей
+// равной степени прекращается. Для подходящего многочлена каждая попытка удачна с вероятностью
+// не меньше 1/2, поэтому исчерпание попыток означает, что не все множители имеют степень degree.
+const equalDegreeAttempts = 64
+
+// DegreeFactor - произведение всех неприводимых множителей одной степени Degree.
+type DegreeFactor struct {
+	Poly   Polynomial
+	Degree int
+}
+
+// FactorCantorZassenhaus раскладывает многочлен над GF(p) на неприводимые нормированные
+// множители вероятностным алгоритмом Кантора-Цассенхауса: выделение бесквадратной части,
+// затем разложение по степеням и расщепление множителей равной степени.
+// Случайные многочлены берутся из rng; при nil используется источник, инициализированный временем.
+func (f SimpleField) FactorCantorZassenhaus(poly Polynomial, rng *rand.Rand) ([]Factor, error) {
+	poly = f.Normalize(poly)
+	if poly.isZeroPolynomial() {
+		err := fmt.Errorf("factorization of zero polynomial is not defined")
+		tryLog(f.enableLogging, err)
+		return nil, err
+	}
+	if rng == nil {
+		rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	factors := []Factor{}
+	for _, part := range f.yun(f.monic(poly)) {
+		for _, group := range f.DistinctDegreeFactorization(part.Poly) {
+			irreducibles, err := f.EqualDegreeFactorization(group.Poly, group.Degree, rng)
+			if err != nil {
+				return nil, err
+			}
+			for _, irreducible := range irreducibles {
+				factors = append(factors, Factor{irreducible, part.Multiplicity})
+			}
+		}
+	}
+	sortFactors(factors)
+	return factors, nil
+}
+
+// DistinctDegreeFactorization разбивает бесквадратный нормированный многочлен на
+// произведения неприводимых множителей одинаковой степени, используя gcd(poly, x^(p^d) - x).
+func (f SimpleField) DistinctDegreeFactorization(poly Polynomial) []DegreeFactor {
+	poly = f.monic(poly)
+	result := []DegreeFactor{}
+
+	x := newPolynomialNoReverse([]int{0, 1})
+	h := x
+	for d := 1; 2*d <= poly.deg; d++ {
+		h = f.PowModPolynomial(h, f.p, poly)
+		g := f.monic(f.GCD(poly, f.SubPolynomials(h, x)))
+		if g.deg > 0 {
+			result = append(result, DegreeFactor{g, d})
+			poly = f.exactDiv(poly, g)
+			_, h, _ = f.DivPolynomials(h, poly)
+		}
+	}
+	if poly.deg > 0 {
+		result = append(result, DegreeFactor{poly, poly.deg})
+	}
+	return result
+}
+
+// EqualDegreeFactorization расщепляет бесквадратный нормированный многочлен, все
+// неприводимые множители которого имеют степень degree. Для нечётного p используется
+// возведение в степень (p^d - 1)/2, для p = 2 - след a + a^2 + ... + a^(2^(d-1)).
+func (f SimpleField) EqualDegreeFactorization(poly Polynomial, degree int, rng *rand.Rand) ([]Polynomial, error) {
+	poly = f.monic(poly)
+	if degree < 1 || poly.deg%degree != 0 {
+		err := fmt.Errorf("degree %d does not divide deg %s = %d", degree, poly.ToString(), poly.deg)
+		tryLog(f.enableLogging, err)
+		return nil, err
+	}
+	if rng == nil {
+		rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	if poly.deg == degree {
+		return []Polynomial{poly}, nil
+	}
+
+	exp := new(big.Int).Exp(big.NewInt(int64(f.p)), big.NewInt(int64(degree)), nil)
+	exp.Sub(exp, big.NewInt(1))
+	exp.Rsh(exp, 1)
+	one := newPolynomialNoReverse([]int{1})
+
+	for attempt := 0; attempt < equalDegreeAttempts; attempt++ {
+		a := f.randomPolynomial(rng, poly.deg)
+		if a.deg < 1 {
+			continue
+		}
+
+		var b Polynomial
+		if f.p == 2 {
+			b = f.trace(a, degree, poly)
+		} else {
+			b = f.SubPolynomials(f.powModPolynomialBig(a, exp, poly), one)
+		}
+
+		g := f.monic(f.GCD(poly, b))
+		if g.deg > 0 && g.deg < poly.deg {
+			left, err := f.EqualDegreeFactorization(g, degree, rng)
+			if err != nil {
+				return nil, err
+			}
+			right, err := f.EqualDegreeFactorization(f.exactDiv(poly, g), degree, rng)
+			if err != nil {
+				return nil, err
+			}
+			return append(left, right...), nil
+		}
+	}
+
+	err := fmt.Errorf("%s does not split into irreducible factors of degree %d over %s", poly.ToString(), degree, f.ToString())
+	tryLog(f.enableLogging, err)
+	return nil, err
+}
+
+// trace вычисляет a + a^2 + a^4 + ... + a^(2^(d-1)) mod poly.
+func (f SimpleField) trace(a Polynomial, degree int, poly Polynomial) Polynomial {
+	_, term, _ := f.DivPolynomials(a, poly)
+	result := term
+	for i := 1; i < degree; i++ {
+		_, term, _ = f.DivPolynomials(f.MulPolynomials(term, term), poly)
+		result = f.AddPolynomials(result, term)
+	}
+	return result
+}
+
+// randomPolynomial возвращает случайный многочлен степени меньше n.
+func (f SimpleField) randomPolynomial(rng *rand.Rand, n int) Polynomial {
+	coefs := make([]int, n)
+	for i := range coefs {
+		coefs[i] = int(rng.Int63n(int64(f.p)))
+	}
+	return newPolynomialNoReverse(coefs)
+}
+
+// powModPolynomialBig аналогична PowModPolynomial для показателей, не помещающихся в int.
+func (f SimpleField) powModPolynomialBig(base Polynomial, exp *big.Int, mod Polynomial) Polynomial {
+	modulus, err := f.NewModulus(mod)
+	if err != nil {
+		return newZeroPolynomial()
+	}
+
+	result := newPolynomialNoReverse([]int{1})
+	current := modulus.Reduce(base)
+
+	for i := 0; i < exp.BitLen(); i++ {
+		if exp.Bit(i) == 1 {
+			result = modulus.Reduce(f.MulPolynomials(result, current))
+		}
+		current = modulus.Reduce(f.MulPolynomials(current, current))
+	}
+
+	return modulus.Reduce(result)
+}
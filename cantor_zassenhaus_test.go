@@ -0,0 +1,129 @@
+package polygfgo
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSimpleField_DistinctDegreeFactorization(t *testing.T) {
+	t.Run("x^8 - x over GF(2) groups all irreducibles of degrees 1 and 3", func(t *testing.T) {
+		f := SimpleField{2, false}
+		poly := NewPolynomial([]int{1, 0, 0, 0, 0, 0, 0, 1, 0})
+
+		got := f.DistinctDegreeFactorization(poly)
+		want := []DegreeFactor{
+			{NewPolynomial([]int{1, 1, 0}), 1},
+			{NewPolynomial([]int{1, 1, 1, 1, 1, 1, 1}), 3},
+		}
+
+		if len(got) != len(want) {
+			t.Fatalf("Expected %d groups but got %d", len(want), len(got))
+		}
+		for i := range want {
+			if !got[i].Poly.Equals(want[i].Poly) || got[i].Degree != want[i].Degree {
+				t.Errorf("Expected %s of degree %d but got %s of degree %d", want[i].Poly.ToString(), want[i].Degree, got[i].Poly.ToString(), got[i].Degree)
+			}
+		}
+	})
+}
+
+func TestSimpleField_EqualDegreeFactorization(t *testing.T) {
+	t.Run("splitting cubics over GF(2) with trace map", func(t *testing.T) {
+		f := SimpleField{2, false}
+		poly := NewPolynomial([]int{1, 1, 1, 1, 1, 1, 1})
+
+		got, err := f.EqualDegreeFactorization(poly, 3, rand.New(rand.NewSource(1)))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if len(got) != 2 || got[0].deg != 3 || got[1].deg != 3 {
+			t.Errorf("Expected two cubic factors but got %v", got)
+		}
+	})
+
+	t.Run("degree must divide the polynomial degree", func(t *testing.T) {
+		f := SimpleField{5, false}
+
+		if _, err := f.EqualDegreeFactorization(NewPolynomial([]int{1, 0, 0, 1}), 2, nil); err == nil {
+			t.Errorf("Expected error for degree 2 and cubic polynomial")
+		}
+	})
+
+	t.Run("irreducible polynomial of larger degree is rejected", func(t *testing.T) {
+		f := SimpleField{3, false}
+		poly := NewPolynomial([]int{1, 0, 0, 1, 2})
+		if !f.IsIrreducible(poly) {
+			t.Fatalf("Expected %s to be irreducible over %s", poly.ToString(), f.ToString())
+		}
+
+		if _, err := f.EqualDegreeFactorization(poly, 2, rand.New(rand.NewSource(1))); err == nil {
+			t.Errorf("Expected error for irreducible quartic and degree 2")
+		}
+	})
+}
+
+func TestSimpleField_FactorCantorZassenhaus(t *testing.T) {
+	t.Run("agrees with Berlekamp for small primes", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(2))
+		for _, p := range []int{2, 3, 7, 101} {
+			f := SimpleField{p, false}
+			for i := 0; i < 10; i++ {
+				poly := f.randomPolynomial(rng, 20)
+				if poly.deg < 1 {
+					continue
+				}
+
+				got, err := f.FactorCantorZassenhaus(poly, rng)
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				want, _ := f.Factor(poly)
+
+				if len(got) != len(want) {
+					t.Fatalf("Expected %d factors but got %d for %s over GF(%d)", len(want), len(got), poly.ToString(), p)
+				}
+				for j := range want {
+					if !got[j].Poly.Equals(want[j].Poly) || got[j].Multiplicity != want[j].Multiplicity {
+						t.Errorf("Expected %s^%d but got %s^%d", want[j].Poly.ToString(), want[j].Multiplicity, got[j].Poly.ToString(), got[j].Multiplicity)
+					}
+				}
+			}
+		}
+	})
+
+	t.Run("large prime 2^61 - 1", func(t *testing.T) {
+		f := SimpleField{2305843009213693951, false}
+		poly := f.MulPolynomials(NewPolynomial([]int{1, -123456789}), NewPolynomial([]int{1, 987654321987}))
+		poly = f.MulPolynomials(poly, NewPolynomial([]int{1, 0, 0, 0, 5}))
+		poly = f.MulPolynomials(poly, NewPolynomial([]int{1, 0, 3}))
+
+		got, err := f.FactorCantorZassenhaus(poly, rand.New(rand.NewSource(3)))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		checkFactorization(t, f, poly, got)
+
+		if len(got) < 3 {
+			t.Errorf("Expected at least 3 factors but got %v", got)
+		}
+	})
+
+	t.Run("reproducible with the same seed", func(t *testing.T) {
+		f := SimpleField{1000000007, false}
+		poly := NewPolynomial([]int{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, -1})
+
+		first, _ := f.FactorCantorZassenhaus(poly, rand.New(rand.NewSource(4)))
+		second, _ := f.FactorCantorZassenhaus(poly, rand.New(rand.NewSource(4)))
+
+		if len(first) != len(second) {
+			t.Fatalf("Expected equal factorizations but got %v and %v", first, second)
+		}
+		for i := range first {
+			if !first[i].Poly.Equals(second[i].Poly) {
+				t.Errorf("Expected %s but got %s", first[i].Poly.ToString(), second[i].Poly.ToString())
+			}
+		}
+		checkFactorization(t, f, poly, first)
+	})
+}
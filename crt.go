@@ -0,0 +1,83 @@
+package polygfgo
+
+import (
+	"fmt"
+)
+
+// CRT по остаткам residues[i] по попарно взаимно простым модулям moduli[i] находит
+// единственный многочлен степени меньше степени произведения модулей с теми же остатками.
+// Пары решений объединяются снизу вверх по дереву произведений модулей.
+func (f SimpleField) CRT(residues, moduli []Polynomial) (Polynomial, error) {
+	if len(residues) != len(moduli) {
+		err := fmt.Errorf("got %d residues but %d moduli", len(residues), len(moduli))
+		tryLog(f.enableLogging, err)
+		return newZeroPolynomial(), err
+	}
+	moduli, err := f.normalizeModuli(moduli)
+	if err != nil {
+		return newZeroPolynomial(), err
+	}
+
+	tree := f.productTree(moduli)
+	level := make([]Polynomial, len(residues))
+	for i := range residues {
+		_, level[i], _ = f.DivPolynomials(residues[i], moduli[i])
+	}
+	for k := 0; k+1 < len(tree); k++ {
+		next := make([]Polynomial, len(tree[k+1]))
+		for i := range next {
+			if 2*i+1 == len(level) {
+				next[i] = level[2*i]
+				continue
+			}
+			combined, err := f.combineResidues(level[2*i], tree[k][2*i], level[2*i+1], tree[k][2*i+1])
+			if err != nil {
+				return newZeroPolynomial(), err
+			}
+			next[i] = combined
+		}
+		level = next
+	}
+	return level[0], nil
+}
+
+// Residues разбивает многочлен на остатки по модулям moduli, спуская остатки по дереву произведений.
+func (f SimpleField) Residues(poly Polynomial, moduli []Polynomial) ([]Polynomial, error) {
+	moduli, err := f.normalizeModuli(moduli)
+	if err != nil {
+		return nil, err
+	}
+	return f.remainderTree(poly, f.productTree(moduli)), nil
+}
+
+// combineResidues находит x = u mod m1, x = v mod m2 в виде x = u + m1*((v - u)*m1^(-1) mod m2).
+func (f SimpleField) combineResidues(u, m1, v, m2 Polynomial) (Polynomial, error) {
+	g, s, _ := f.ExtendedGCD(m1, m2)
+	if g.deg != 0 {
+		err := fmt.Errorf("moduli %s and %s are not coprime", m1.ToString(), m2.ToString())
+		tryLog(f.enableLogging, err)
+		return newZeroPolynomial(), err
+	}
+
+	_, k, _ := f.DivPolynomials(f.MulPolynomials(f.SubPolynomials(v, u), s), m2)
+	return f.AddPolynomials(u, f.MulPolynomials(m1, k)), nil
+}
+
+// normalizeModuli приводит модули по модулю p и проверяет, что среди них нет нулевых.
+func (f SimpleField) normalizeModuli(moduli []Polynomial) ([]Polynomial, error) {
+	if len(moduli) == 0 {
+		err := fmt.Errorf("at least one modulus is required")
+		tryLog(f.enableLogging, err)
+		return nil, err
+	}
+	result := make([]Polynomial, len(moduli))
+	for i := range moduli {
+		result[i] = f.Normalize(moduli[i])
+		if result[i].isZeroPolynomial() {
+			err := fmt.Errorf("modulus %d is zero over %s", i, f.ToString())
+			tryLog(f.enableLogging, err)
+			return nil, err
+		}
+	}
+	return result, nil
+}
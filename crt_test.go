@@ -0,0 +1,81 @@
+package polygfgo
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSimpleField_CRT(t *testing.T) {
+	t.Run("linear moduli reduce to interpolation", func(t *testing.T) {
+		f := SimpleField{7, false}
+		moduli := []Polynomial{NewPolynomial([]int{1, 0}), NewPolynomial([]int{1, -1}), NewPolynomial([]int{1, -2})}
+		residues := []Polynomial{NewPolynomial([]int{1}), NewPolynomial([]int{2}), NewPolynomial([]int{5})}
+
+		got, err := f.CRT(residues, moduli)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := NewPolynomial([]int{1, 0, 1})
+
+		if !got.Equals(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+	})
+
+	t.Run("round trip with Residues", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(1))
+		f := SimpleField{1000000007, false}
+		moduli := []Polynomial{}
+		degree := 0
+		for len(moduli) < 11 {
+			candidate := f.AddPolynomials(f.randomPolynomial(rng, 5), newMonomialPolynomial(5))
+			coprime := true
+			for _, m := range moduli {
+				if f.GCD(m, candidate).deg > 0 {
+					coprime = false
+				}
+			}
+			if coprime {
+				moduli = append(moduli, candidate)
+				degree += candidate.deg
+			}
+		}
+		poly := f.randomPolynomial(rng, degree)
+
+		residues, err := f.Residues(poly, moduli)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		for i, r := range residues {
+			if _, want, _ := f.DivPolynomials(poly, moduli[i]); !r.Equals(want) {
+				t.Errorf("Expected residue %s but got %s", want.ToString(), r.ToString())
+			}
+		}
+
+		got, err := f.CRT(residues, moduli)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !got.Equals(poly) {
+			t.Errorf("Expected %s but got %s", poly.ToString(), got.ToString())
+		}
+	})
+
+	t.Run("moduli with a common factor", func(t *testing.T) {
+		f := SimpleField{5, false}
+		moduli := []Polynomial{NewPolynomial([]int{1, 0, -1}), NewPolynomial([]int{1, -1})}
+		residues := []Polynomial{NewPolynomial([]int{1}), NewPolynomial([]int{2})}
+
+		if _, err := f.CRT(residues, moduli); err == nil {
+			t.Errorf("Expected error for moduli sharing x - 1")
+		}
+	})
+
+	t.Run("zero modulus", func(t *testing.T) {
+		f := SimpleField{5, false}
+
+		if _, err := f.Residues(NewPolynomial([]int{1, 2}), []Polynomial{NewPolynomial([]int{10})}); err == nil {
+			t.Errorf("Expected error for zero modulus")
+		}
+	})
+}
@@ -0,0 +1,185 @@
+package polygfgo
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/rand"
+)
+
+// babyStepGiantStepLimit - наибольший простой порядок подгруппы, в которой логарифм ищется
+// алгоритмом Шенкса; для больших порядков используется ро-метод Полларда.
+const babyStepGiantStepLimit = 1 << 24
+
+// pollardRhoAttempts - число запусков ро-метода Полларда со случайных начальных точек;
+// каждый запуск ограничен pollardRhoStepFactor * sqrt(r) шагами, где ожидаемая длина
+// хвоста и цикла - около 1.25 * sqrt(r).
+const (
+	pollardRhoAttempts   = 16
+	pollardRhoStepFactor = 8
+)
+
+// DiscreteLog находит наименьшее x >= 0, для которого base^x = target в GF(q)*.
+// Используется алгоритм Полига-Хеллмана по разложению порядка base: логарифм по модулю
+// каждой степени простого r^e собирается по цифрам, каждая цифра ищется в подгруппе
+// порядка r алгоритмом Шенкса или ро-методом Полларда. Ответ собирается по китайской теореме об остатках.
+func DiscreteLog(base, target Element) (int, error) {
+	if !sameField(base.field, target.field) {
+		return 0, fmt.Errorf("elements belong to different fields: %s and %s", base.field.ToString(), target.field.ToString())
+	}
+	if base.IsZero() || target.IsZero() {
+		return 0, fmt.Errorf("discrete logarithm is defined only for nonzero elements of %s", base.field.ToString())
+	}
+
+	groupOrder, err := multiplicativeOrder(base.field.GetPrime(), base.field.GetDegree())
+	if err != nil {
+		return 0, err
+	}
+	n := elementOrder(base, groupOrder, Factorize(groupOrder))
+
+	x, modulus := 0, 1
+	for _, pp := range Factorize(n) {
+		pe := intPow(pp.Prime, pp.Exponent)
+		g, _ := base.Pow(n / pe)
+		h, _ := target.Pow(n / pe)
+
+		digit, err := primePowerLog(g, h, pp.Prime, pp.Exponent)
+		if err != nil {
+			return 0, fmt.Errorf("%s is not a power of %s", target.ToString(), base.ToString())
+		}
+
+		// x = x mod modulus и x = digit mod pe
+		k := mulMod(uint64(reduceInt(digit-x, pe)), uint64(modInverse(modulus%pe, pe)), uint64(pe))
+		x += modulus * int(k)
+		modulus *= pe
+	}
+
+	if check, _ := base.Pow(x); !check.Equal(target) {
+		return 0, fmt.Errorf("%s is not a power of %s", target.ToString(), base.ToString())
+	}
+	return x, nil
+}
+
+// primePowerLog находит логарифм h по основанию g порядка r^e, определяя цифры ответа
+// в системе счисления по основанию r в подгруппе порядка r.
+func primePowerLog(g, h Element, r, e int) (int, error) {
+	gamma, _ := g.Pow(intPow(r, e-1))
+	gInv, err := g.Inv()
+	if err != nil {
+		return 0, err
+	}
+
+	x, power := 0, 1
+	for k := 0; k < e; k++ {
+		shifted, _ := gInv.Pow(x)
+		hk, _ := shifted.Mul(h).Pow(intPow(r, e-1-k))
+
+		var digit int
+		if r <= babyStepGiantStepLimit {
+			digit, err = babyStepGiantStep(gamma, hk, r)
+		} else {
+			digit, err = pollardRhoLog(gamma, hk, r, rand.New(rand.NewSource(int64(r))))
+		}
+		if err != nil {
+			return 0, err
+		}
+		x += digit * power
+		power *= r
+	}
+	return x, nil
+}
+
+// babyStepGiantStep находит x < order с g^x = h алгоритмом Шенкса за O(sqrt(order)) операций.
+func babyStepGiantStep(g, h Element, order int) (int, error) {
+	m := int(math.Ceil(math.Sqrt(float64(order))))
+	table := make(map[string]int, m)
+	baby := OneElement(g.field)
+	for j := 0; j < m; j++ {
+		key := baby.value.ToString()
+		if _, ok := table[key]; !ok {
+			table[key] = j
+		}
+		baby = baby.Mul(g)
+	}
+
+	// baby = g^m; шагаем h * g^(-m*i)
+	giant, err := baby.Inv()
+	if err != nil {
+		return 0, err
+	}
+	current := h
+	for i := 0; i < m; i++ {
+		if j, ok := table[current.value.ToString()]; ok {
+			return (i*m + j) % order, nil
+		}
+		current = current.Mul(giant)
+	}
+	return 0, fmt.Errorf("%s is not in the subgroup generated by %s", h.ToString(), g.ToString())
+}
+
+// pollardRhoLog находит x с g^x = h в подгруппе простого порядка order ро-методом Полларда.
+// Последовательность g^a h^b разбивается на три класса по хешу элемента, цикл ищется методом Флойда.
+// Если за отведённое число шагов и перезапусков логарифм не найден, возвращается ошибка.
+func pollardRhoLog(g, h Element, order int, rng *rand.Rand) (int, error) {
+	r := uint64(order)
+	step := func(x Element, a, b uint64) (Element, uint64, uint64) {
+		switch elementHash(x) % 3 {
+		case 0:
+			return x.Mul(h), a, addMod(b, 1, r)
+		case 1:
+			return x.Mul(x), addMod(a, a, r), addMod(b, b, r)
+		default:
+			return x.Mul(g), addMod(a, 1, r), b
+		}
+	}
+
+	maxSteps := pollardRhoStepFactor * int(math.Ceil(math.Sqrt(float64(order))))
+	for attempt := 0; attempt < pollardRhoAttempts; attempt++ {
+		a, b := uint64(rng.Int63n(int64(order))), uint64(rng.Int63n(int64(order)))
+		ga, _ := g.Pow(int(a))
+		hb, _ := h.Pow(int(b))
+		x := ga.Mul(hb)
+		y, c, d := x, a, b
+
+		for i := 0; i < maxSteps; i++ {
+			x, a, b = step(x, a, b)
+			y, c, d = step(y, c, d)
+			y, c, d = step(y, c, d)
+			if !x.Equal(y) {
+				continue
+			}
+
+			// g^a h^b = g^c h^d, откуда (d - b) * log = a - c
+			if b == d {
+				break
+			}
+			log := mulMod(subMod(a, c, r), uint64(modInverse(int(subMod(d, b, r)), order)), r)
+			if check, _ := g.Pow(int(log)); check.Equal(h) {
+				return int(log), nil
+			}
+			break
+		}
+	}
+	return 0, fmt.Errorf("pollard rho found no logarithm of %s to base %s in %d attempts of %d steps", h.ToString(), g.ToString(), pollardRhoAttempts, maxSteps)
+}
+
+// elementHash возвращает хеш значения элемента.
+func elementHash(e Element) uint64 {
+	h := fnv.New64a()
+	for _, c := range e.value.coefs[:e.value.deg+1] {
+		var buf [8]byte
+		for i := range buf {
+			buf[i] = byte(uint64(c) >> (8 * i))
+		}
+		h.Write(buf[:])
+	}
+	return h.Sum64()
+}
+
+func intPow(base, exp int) int {
+	result := 1
+	for i := 0; i < exp; i++ {
+		result *= base
+	}
+	return result
+}
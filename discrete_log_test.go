@@ -0,0 +1,118 @@
+package polygfgo
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestDiscreteLog(t *testing.T) {
+	t.Run("logarithms in GF(2^8) with base 0x03", func(t *testing.T) {
+		f := newAESField()
+		base := byteElement(f, 0x03)
+		for _, x := range []int{0, 1, 77, 200, 254} {
+			target, _ := base.Pow(x)
+
+			got, err := DiscreteLog(base, target)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if got != x {
+				t.Errorf("Expected %d but got %d", x, got)
+			}
+		}
+	})
+
+	t.Run("prime field with composite group order", func(t *testing.T) {
+		f := SimpleField{1000003, false}
+		base := NewElementFromInt(f, f.FindPrimitiveElement())
+		rng := rand.New(rand.NewSource(1))
+		for i := 0; i < 5; i++ {
+			target := NewElementFromInt(f, 1+rng.Intn(1000002))
+
+			x, err := DiscreteLog(base, target)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if check, _ := base.Pow(x); !check.Equal(target) || x >= 1000002 {
+				t.Errorf("Expected %s but got %s", target.ToString(), check.ToString())
+			}
+		}
+	})
+
+	t.Run("extension field GF(3^7)", func(t *testing.T) {
+		field, _ := NewField(3, 7)
+		base := findPrimitiveElement(field, 2186, Factorize(2186))
+		target := elementFromIndex(field, 1234)
+
+		x, err := DiscreteLog(base, target)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if check, _ := base.Pow(x); !check.Equal(target) {
+			t.Errorf("Expected %s but got %s", target.ToString(), check.ToString())
+		}
+	})
+
+	t.Run("target outside the subgroup", func(t *testing.T) {
+		f := SimpleField{7, false}
+
+		// 2 имеет порядок 3 в GF(7)*, а 3 - порождающий элемент
+		if _, err := DiscreteLog(NewElementFromInt(f, 2), NewElementFromInt(f, 3)); err == nil {
+			t.Errorf("Expected error for 3 outside <2>")
+		}
+	})
+
+	t.Run("zero target", func(t *testing.T) {
+		f := SimpleField{7, false}
+
+		if _, err := DiscreteLog(NewElementFromInt(f, 3), ZeroElement(f)); err == nil {
+			t.Errorf("Expected error for zero target")
+		}
+	})
+
+	t.Run("elements of different fields", func(t *testing.T) {
+		base := NewElementFromInt(SimpleField{7, false}, 3)
+		target := NewElementFromInt(SimpleField{11, false}, 2)
+
+		if _, err := DiscreteLog(base, target); err == nil {
+			t.Errorf("Expected error for elements of GF(7) and GF(11)")
+		}
+	})
+}
+
+func TestPollardRhoLog(t *testing.T) {
+	// 1000003 - 1 = 2 * 3 * 166667, подгруппа простого порядка 166667
+	f := SimpleField{1000003, false}
+	g, _ := NewElementFromInt(f, 2).Pow(6)
+	rng := rand.New(rand.NewSource(2))
+
+	for _, x := range []int{1, 12345, 166666} {
+		h, _ := g.Pow(x)
+
+		got, err := pollardRhoLog(g, h, 166667, rng)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got != x {
+			t.Errorf("Expected %d but got %d", x, got)
+		}
+
+		if got, _ := babyStepGiantStep(g, h, 166667); got != x {
+			t.Errorf("Expected %d but got %d", x, got)
+		}
+	}
+}
+
+func TestPollardRhoLog_Bounded(t *testing.T) {
+	f := SimpleField{1000003, false}
+	g, _ := NewElementFromInt(f, 2).Pow(6)
+	// -1 имеет порядок 2 и не лежит в подгруппе порядка 166667
+	h := NewElementFromInt(f, -1)
+
+	if _, err := pollardRhoLog(g, h, 166667, rand.New(rand.NewSource(3))); err == nil {
+		t.Errorf("Expected error for element outside the subgroup")
+	}
+}
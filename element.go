@@ -0,0 +1,152 @@
+package polygfgo
+
+import (
+	"fmt"
+)
+
+// Element - элемент конечного поля, привязанный к своему полю.
+// Значение хранится в виде многочлена, уже приведённого по модулю поля:
+// для GF(p) это константа, для GF(p^m) - многочлен степени меньше m.
+type Element struct {
+	field FieldInterface
+	value Polynomial
+}
+
+// NewElement создаёт элемент поля field из многочлена value.
+// Коэффициенты приводятся по модулю p, а для расширенного поля многочлен
+// дополнительно приводится по модулю порождающего многочлена.
+func NewElement(field FieldInterface, value Polynomial) (Element, error) {
+	reduced := field.AddPolynomials(value, newZeroPolynomial())
+	if reduced.deg >= field.GetDegree() {
+		return Element{}, fmt.Errorf("polynomial %s is not an element of %s", value.ToString(), field.ToString())
+	}
+	return Element{field, reduced}, nil
+}
+
+// NewElementFromInt создаёт элемент простого подполя, соответствующий числу n mod p.
+func NewElementFromInt(field FieldInterface, n int) Element {
+	value := field.AddPolynomials(newPolynomialNoReverse([]int{n}), newZeroPolynomial())
+	return Element{field, value}
+}
+
+func ZeroElement(field FieldInterface) Element {
+	return Element{field, newZeroPolynomial()}
+}
+
+func OneElement(field FieldInterface) Element {
+	return Element{field, newPolynomialNoReverse([]int{1})}
+}
+
+func (e Element) Field() FieldInterface {
+	return e.field
+}
+
+// Value возвращает многочлен, представляющий элемент.
+func (e Element) Value() Polynomial {
+	return newPolynomialNoReverse(e.value.coefs)
+}
+
+func (e Element) IsZero() bool {
+	return e.value.isZeroPolynomial()
+}
+
+func (e Element) IsOne() bool {
+	return e.value.Equals(newPolynomialNoReverse([]int{1}))
+}
+
+// Equal сравнивает элементы; элементы разных полей не равны.
+func (e Element) Equal(other Element) bool {
+	return sameField(e.field, other.field) && e.value.Equals(other.value)
+}
+
+func (e Element) Add(other Element) Element {
+	mustSameField(e, other)
+	return Element{e.field, e.field.AddPolynomials(e.value, other.value)}
+}
+
+func (e Element) Sub(other Element) Element {
+	mustSameField(e, other)
+	return Element{e.field, e.field.SubPolynomials(e.value, other.value)}
+}
+
+func (e Element) Neg() Element {
+	return Element{e.field, e.field.SubPolynomials(newZeroPolynomial(), e.value)}
+}
+
+func (e Element) Mul(other Element) Element {
+	mustSameField(e, other)
+	return Element{e.field, e.field.MulPolynomials(e.value, other.value)}
+}
+
+// Inv возвращает обратный элемент; для нуля возвращается ошибка.
+func (e Element) Inv() (Element, error) {
+	if e.IsZero() {
+		return Element{}, fmt.Errorf("zero element of %s has no inverse", e.field.ToString())
+	}
+	inverse, err := e.field.InvPolynomial(e.value)
+	if err != nil {
+		return Element{}, err
+	}
+	return Element{e.field, inverse}, nil
+}
+
+func (e Element) Div(other Element) (Element, error) {
+	mustSameField(e, other)
+	inverse, err := other.Inv()
+	if err != nil {
+		return Element{}, err
+	}
+	return e.Mul(inverse), nil
+}
+
+// Pow возводит элемент в степень n; отрицательная степень означает
+// возведение в степень обратного элемента.
+func (e Element) Pow(n int) (Element, error) {
+	base := e
+	if n < 0 {
+		inverse, err := e.Inv()
+		if err != nil {
+			return Element{}, err
+		}
+		base, n = inverse, -n
+	}
+
+	result := OneElement(e.field)
+	for n > 0 {
+		if n%2 == 1 {
+			result = result.Mul(base)
+		}
+		base = base.Mul(base)
+		n /= 2
+	}
+	return result, nil
+}
+
+func (e Element) ToString() string {
+	return fmt.Sprintf("%s in %s", e.value.ToString(), e.field.ToString())
+}
+
+// fieldOverBase реализуют поля, построенные над другим полем, например TowerField:
+// их порождающий многочлен определяет поле только вместе с базой.
+type fieldOverBase interface {
+	Base() FieldInterface
+}
+
+// Поля считаются одинаковыми, если совпадают p, m и порождающий многочлен, а для башен - и база.
+func sameField(f1, f2 FieldInterface) bool {
+	if f1.GetPrime() != f2.GetPrime() || f1.GetDegree() != f2.GetDegree() {
+		return false
+	}
+	b1, ok1 := f1.(fieldOverBase)
+	b2, ok2 := f2.(fieldOverBase)
+	if ok1 != ok2 || ok1 && !sameField(b1.Base(), b2.Base()) {
+		return false
+	}
+	return f1.GetIrreducible().Equals(f2.GetIrreducible())
+}
+
+func mustSameField(e1, e2 Element) {
+	if !sameField(e1.field, e2.field) {
+		panic(fmt.Sprintf("elements belong to different fields: %s and %s", e1.field.ToString(), e2.field.ToString()))
+	}
+}
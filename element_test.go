@@ -0,0 +1,133 @@
+package polygfgo
+
+import "testing"
+
+func TestElement_SimpleField(t *testing.T) {
+	f := SimpleField{7, false}
+
+	t.Run("arithmetic of elements of GF(7)", func(t *testing.T) {
+		a := NewElementFromInt(f, 3)
+		b := NewElementFromInt(f, -2)
+
+		if got, want := a.Add(b), NewElementFromInt(f, 1); !got.Equal(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+		if got, want := a.Sub(b), NewElementFromInt(f, 5); !got.Equal(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+		if got, want := a.Mul(b), NewElementFromInt(f, 1); !got.Equal(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+		if got, want := a.Neg(), NewElementFromInt(f, 4); !got.Equal(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+	})
+
+	t.Run("inverse and division in GF(7)", func(t *testing.T) {
+		a := NewElementFromInt(f, 3)
+
+		inv, err := a.Inv()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if want := NewElementFromInt(f, 5); !inv.Equal(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), inv.ToString())
+		}
+
+		quot, err := NewElementFromInt(f, 1).Div(a)
+		if err != nil || !quot.Equal(inv) {
+			t.Errorf("Expected %s but got %s (%v)", inv.ToString(), quot.ToString(), err)
+		}
+	})
+
+	t.Run("inverse of zero element", func(t *testing.T) {
+		if _, err := ZeroElement(f).Inv(); err == nil {
+			t.Errorf("Expected error for inverse of zero in %s", f.ToString())
+		}
+	})
+
+	t.Run("element with positive degree does not belong to GF(p)", func(t *testing.T) {
+		if _, err := NewElement(f, NewPolynomial([]int{1, 0})); err == nil {
+			t.Errorf("Expected error for polynomial of degree 1 in %s", f.ToString())
+		}
+	})
+}
+
+func TestElement_ExtendedField(t *testing.T) {
+	// GF(2^3) mod x^3 + x + 1
+	f := ExtendedField{SimpleField{2, false}, 2, 3, NewPolynomial([]int{1, 0, 1, 1}), false}
+
+	t.Run("element is reduced by the generator", func(t *testing.T) {
+		got, err := NewElement(f, NewPolynomial([]int{1, 0, 0, 0}))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := NewPolynomial([]int{1, 1})
+
+		if !got.Value().Equals(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.Value().ToString())
+		}
+	})
+
+	t.Run("multiplication of elements", func(t *testing.T) {
+		a, _ := NewElement(f, NewPolynomial([]int{1, 0, 1}))
+		b, _ := NewElement(f, NewPolynomial([]int{1, 1}))
+
+		got := a.Mul(b)
+		want := NewPolynomial([]int{1, 0, 0})
+
+		if !got.Value().Equals(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.Value().ToString())
+		}
+	})
+
+	t.Run("every nonzero element has an inverse", func(t *testing.T) {
+		for i := 1; i < 8; i++ {
+			a, _ := NewElement(f, NewPolynomial([]int{i >> 2 & 1, i >> 1 & 1, i & 1}))
+			inv, err := a.Inv()
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !a.Mul(inv).IsOne() {
+				t.Errorf("Wrong inverse %s for %s", inv.ToString(), a.ToString())
+			}
+		}
+	})
+
+	t.Run("power of an element", func(t *testing.T) {
+		x, _ := NewElement(f, NewPolynomial([]int{1, 0}))
+
+		got, _ := x.Pow(7)
+		if !got.IsOne() {
+			t.Errorf("Expected x^7 = 1 but got %s", got.ToString())
+		}
+
+		got, _ = x.Pow(-1)
+		want, _ := x.Pow(6)
+		if !got.Equal(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+	})
+
+	t.Run("elements of different fields are not equal", func(t *testing.T) {
+		a := OneElement(f)
+		b := OneElement(SimpleField{2, false})
+
+		if a.Equal(b) {
+			t.Errorf("Expected elements of %s and %s to differ", f.ToString(), b.Field().ToString())
+		}
+	})
+
+	t.Run("fields are compared by parameters", func(t *testing.T) {
+		same := ExtendedField{SimpleField{2, false}, 2, 3, f.GetIrreducible(), false}
+		if !sameField(f, same) {
+			t.Errorf("Expected %s and %s to be the same field", f.ToString(), same.ToString())
+		}
+
+		gf16, _ := newCompositeAESField(t)
+		flat := ExtendedField{SimpleField{2, false}, 2, 4, gf16.GetIrreducible(), false}
+		if sameField(gf16, flat) || sameField(flat, gf16) {
+			t.Errorf("Expected %s and %s to differ", gf16.ToString(), flat.ToString())
+		}
+	})
+}
@@ -0,0 +1,135 @@
+package polygfgo
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Factor - неприводимый нормированный множитель многочлена и его кратность.
+type Factor struct {
+	Poly         Polynomial
+	Multiplicity int
+}
+
+// Factor раскладывает многочлен над GF(p) на неприводимые нормированные множители
+// алгоритмом Берлекэмпа. Старший коэффициент в разложение не включается.
+// Число шагов расщепления пропорционально p, поэтому метод предназначен для небольших p.
+func (f SimpleField) Factor(poly Polynomial) ([]Factor, error) {
+	poly = f.Normalize(poly)
+	if poly.isZeroPolynomial() {
+		err := fmt.Errorf("factorization of zero polynomial is not defined")
+		tryLog(f.enableLogging, err)
+		return nil, err
+	}
+
+	factors := []Factor{}
+	for _, part := range f.yun(f.monic(poly)) {
+		for _, irreducible := range f.berlekamp(part.Poly) {
+			factors = append(factors, Factor{irreducible, part.Multiplicity})
+		}
+	}
+	sortFactors(factors)
+	return factors, nil
+}
+
+// berlekamp раскладывает бесквадратный нормированный многочлен на неприводимые множители.
+func (f SimpleField) berlekamp(poly Polynomial) []Polynomial {
+	n := poly.deg
+	if n <= 1 {
+		return []Polynomial{poly}
+	}
+
+	// Строки матрицы Q - коэффициенты x^(p*i) mod poly; ищем v с v*(Q - I) = 0
+	xp := f.PowModPolynomial(newPolynomialNoReverse([]int{0, 1}), f.p, poly)
+	row := newPolynomialNoReverse([]int{1})
+	entries := make([][]int, n)
+	for i := 0; i < n; i++ {
+		entries[i] = make([]int, n)
+		for j := 0; j < n; j++ {
+			entries[i][j] = coefAt(row, j)
+		}
+		entries[i][i]--
+		_, row, _ = f.DivPolynomials(f.MulPolynomials(row, xp), poly)
+	}
+
+	// v*A = 0 эквивалентно A^T * v = 0
+	matrix, _ := NewMatrixFromInts(f, entries)
+	basis := matrix.Transpose().NullSpace()
+	if len(basis) == 1 {
+		return []Polynomial{poly}
+	}
+
+	factors := []Polynomial{poly}
+	for _, v := range basis {
+		coefs := make([]int, n)
+		for j, e := range v {
+			coefs[j] = coefAt(e.value, 0)
+		}
+		vPoly := newPolynomialNoReverse(coefs)
+		if vPoly.deg < 1 {
+			continue
+		}
+		for i := 0; i < len(factors) && len(factors) < len(basis); i++ {
+			u := factors[i]
+			if u.deg <= 1 {
+				continue
+			}
+			for s := 0; s < f.p; s++ {
+				d := f.monic(f.GCD(u, f.SubPolynomials(vPoly, newPolynomialNoReverse([]int{s}))))
+				if d.deg > 0 && d.deg < u.deg {
+					factors[i] = d
+					factors = append(factors, f.exactDiv(u, d))
+					u = d
+					if len(factors) == len(basis) {
+						break
+					}
+				}
+			}
+		}
+	}
+	return factors
+}
+
+// monic делит многочлен на старший коэффициент.
+func (f SimpleField) monic(poly Polynomial) Polynomial {
+	poly = f.Normalize(poly)
+	if poly.deg < 0 {
+		return poly
+	}
+	inv := uint64(modInverse(poly.coefs[poly.deg], f.p))
+	coefs := make([]int, poly.len)
+	for i, c := range poly.coefs {
+		coefs[i] = int(mulMod(uint64(c), inv, uint64(f.p)))
+	}
+	return newPolynomialNoReverse(coefs)
+}
+
+// exactDiv возвращает частное при делении без остатка.
+func (f SimpleField) exactDiv(p1, p2 Polynomial) Polynomial {
+	quot, _, _ := f.DivPolynomials(p1, p2)
+	return quot
+}
+
+// sortFactors упорядочивает множители по степени, затем по коэффициентам начиная со старшего.
+func sortFactors(factors []Factor) {
+	sort.Slice(factors, func(i, j int) bool {
+		a, b := factors[i].Poly, factors[j].Poly
+		if !a.Equals(b) {
+			return lessPolynomial(a, b)
+		}
+		return factors[i].Multiplicity < factors[j].Multiplicity
+	})
+}
+
+// lessPolynomial сравнивает многочлены по степени, затем по коэффициентам начиная со старшего.
+func lessPolynomial(a, b Polynomial) bool {
+	if a.deg != b.deg {
+		return a.deg < b.deg
+	}
+	for k := a.deg; k >= 0; k-- {
+		if a.coefs[k] != b.coefs[k] {
+			return a.coefs[k] < b.coefs[k]
+		}
+	}
+	return false
+}
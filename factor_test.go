@@ -0,0 +1,144 @@
+package polygfgo
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// expandFactors перемножает множители с учётом кратностей.
+func expandFactors(f SimpleField, factors []Factor) Polynomial {
+	product := newPolynomialNoReverse([]int{1})
+	for _, factor := range factors {
+		for i := 0; i < factor.Multiplicity; i++ {
+			product = f.MulPolynomials(product, factor.Poly)
+		}
+	}
+	return product
+}
+
+func checkFactorization(t *testing.T, f SimpleField, poly Polynomial, factors []Factor) {
+	t.Helper()
+	for _, factor := range factors {
+		if !f.IsIrreducible(factor.Poly) {
+			t.Errorf("Factor %s is not irreducible", factor.Poly.ToString())
+		}
+		if factor.Poly.coefs[factor.Poly.deg] != 1 {
+			t.Errorf("Factor %s is not monic", factor.Poly.ToString())
+		}
+	}
+	if got := expandFactors(f, factors); !got.Equals(f.monic(poly)) {
+		t.Errorf("Expected product %s but got %s", f.monic(poly).ToString(), got.ToString())
+	}
+}
+
+func TestSimpleField_Factor(t *testing.T) {
+	t.Run("x^4 - 1 splits into linear factors over GF(5)", func(t *testing.T) {
+		f := SimpleField{5, false}
+		poly := NewPolynomial([]int{1, 0, 0, 0, -1})
+
+		got, err := f.Factor(poly)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := []Factor{
+			{NewPolynomial([]int{1, 1}), 1},
+			{NewPolynomial([]int{1, 2}), 1},
+			{NewPolynomial([]int{1, 3}), 1},
+			{NewPolynomial([]int{1, 4}), 1},
+		}
+
+		if len(got) != len(want) {
+			t.Fatalf("Expected %d factors but got %d", len(want), len(got))
+		}
+		for i := range want {
+			if !got[i].Poly.Equals(want[i].Poly) || got[i].Multiplicity != want[i].Multiplicity {
+				t.Errorf("Expected %s^%d but got %s^%d", want[i].Poly.ToString(), want[i].Multiplicity, got[i].Poly.ToString(), got[i].Multiplicity)
+			}
+		}
+	})
+
+	t.Run("repeated factors over GF(2)", func(t *testing.T) {
+		f := SimpleField{2, false}
+		x := NewPolynomial([]int{1, 0})
+		q := NewPolynomial([]int{1, 1, 1})
+		c := NewPolynomial([]int{1, 0, 1, 1})
+		poly := f.MulPolynomials(f.MulPolynomials(f.MulPolynomials(x, x), f.MulPolynomials(x, q)), f.MulPolynomials(q, c))
+
+		got, err := f.Factor(poly)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		checkFactorization(t, f, poly, got)
+
+		want := map[string]int{x.ToString(): 3, q.ToString(): 2, c.ToString(): 1}
+		if len(got) != len(want) {
+			t.Fatalf("Expected %d factors but got %d", len(want), len(got))
+		}
+		for _, factor := range got {
+			if want[factor.Poly.ToString()] != factor.Multiplicity {
+				t.Errorf("Unexpected factor %s^%d", factor.Poly.ToString(), factor.Multiplicity)
+			}
+		}
+	})
+
+	t.Run("p-th powers over GF(3)", func(t *testing.T) {
+		f := SimpleField{3, false}
+		linear := NewPolynomial([]int{1, 1})
+		quadratic := NewPolynomial([]int{1, 0, 1})
+		poly := f.PowModPolynomial(linear, 3, NewPolynomial([]int{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}))
+		poly = f.MulPolynomials(poly, f.MulPolynomials(quadratic, f.MulPolynomials(quadratic, f.MulPolynomials(quadratic, quadratic))))
+		poly = f.MulPolynomials(poly, newPolynomialNoReverse([]int{2}))
+
+		got, err := f.Factor(poly)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		checkFactorization(t, f, poly, got)
+
+		if len(got) != 2 || got[0].Multiplicity != 3 || got[1].Multiplicity != 4 {
+			t.Errorf("Expected (x + 1)^3 (x^2 + 1)^4 but got %v", got)
+		}
+	})
+
+	t.Run("irreducible polynomial is its own factorization", func(t *testing.T) {
+		f := SimpleField{37, false}
+		poly := Polynomial{[]int{27, 29, 18, 29, 17, 23, 25, 24, 14, 1}, 10, 9}
+
+		got, err := f.Factor(poly)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if len(got) != 1 || !got[0].Poly.Equals(poly) {
+			t.Errorf("Expected %s but got %v", poly.ToString(), got)
+		}
+	})
+
+	t.Run("product of many factors over GF(7)", func(t *testing.T) {
+		f := SimpleField{7, false}
+		poly := NewPolynomial([]int{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, -1})
+
+		got, err := f.Factor(poly)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		checkFactorization(t, f, poly, got)
+	})
+
+	t.Run("factorization of zero polynomial", func(t *testing.T) {
+		f := SimpleField{7, false}
+
+		if _, err := f.Factor(newZeroPolynomial()); err == nil {
+			t.Errorf("Expected error for zero polynomial")
+		}
+	})
+}
+
+func BenchmarkSimpleField_Factor(b *testing.B) {
+	f := SimpleField{3, false}
+	rng := rand.New(rand.NewSource(1))
+	poly := f.AddPolynomials(f.randomPolynomial(rng, 150), newMonomialPolynomial(150))
+	for i := 0; i < b.N; i++ {
+		f.Factor(poly)
+	}
+}
@@ -12,6 +12,15 @@ import (
 
 const UNIT_DEGREE = 1
 
+// Ошибки проверки параметров поля в StrictFieldFactory
+var (
+	ErrInvalidParameters  = errors.New("invalid field parameters")
+	ErrNotPrime           = errors.New("characteristic is not a prime number")
+	ErrGeneratorDegree    = errors.New("generator degree does not match the extension degree")
+	ErrGeneratorNotMonic  = errors.New("generator is not monic")
+	ErrGeneratorReducible = errors.New("generator is reducible")
+)
+
 type FieldInterface interface {
 	GetPrime() int
 	GetDegree() int
@@ -20,6 +29,7 @@ type FieldInterface interface {
 	SubPolynomials(p1, p2 Polynomial) Polynomial
 	MulPolynomials(p1, p2 Polynomial) Polynomial
 	DivPolynomials(p1, p2 Polynomial) (Polynomial, Polynomial, error)
+	InvPolynomial(poly Polynomial) (Polynomial, error)
 	IsIrreducible(poly Polynomial) bool
 	GCD(p1, p2 Polynomial) Polynomial
 	ToString() string
@@ -44,6 +54,102 @@ func FieldFactory(p, m int, generator Polynomial, enableLogging bool) (field Fie
 	return
 }
 
+// StrictFieldFactory создаёт поле GF(p^m), как FieldFactory, но предварительно проверяет,
+// что p простое, а порождающий многочлен нормирован, имеет степень ровно m и неприводим.
+// Для m = 1 порождающий многочлен можно не задавать (передать нулевой многочлен).
+// Возвращаемые ошибки оборачивают ErrInvalidParameters, ErrNotPrime, ErrGeneratorDegree,
+// ErrGeneratorNotMonic или ErrGeneratorReducible и проверяются через errors.Is.
+func StrictFieldFactory(p, m int, generator Polynomial, enableLogging bool) (field FieldInterface, err error) {
+	defer func() {
+		if err != nil {
+			tryLog(enableLogging, err)
+		}
+	}()
+
+	if p < 2 || m < 1 {
+		err = fmt.Errorf("%w: p=%d < 2 or m=%d < 1", ErrInvalidParameters, p, m)
+		return
+	}
+	if !IsPrime(p) {
+		err = fmt.Errorf("%w: p=%d", ErrNotPrime, p)
+		return
+	}
+
+	simple := SimpleField{p, enableLogging}
+	generator = simple.Normalize(generator)
+	if m == 1 && generator.isZeroPolynomial() {
+		field = simple
+		return
+	}
+	if generator.deg != m {
+		err = fmt.Errorf("%w: deg %s = %d, m = %d", ErrGeneratorDegree, generator.ToString(), generator.deg, m)
+		return
+	}
+	if generator.coefs[generator.deg] != 1 {
+		err = fmt.Errorf("%w: leading coefficient of %s is %d", ErrGeneratorNotMonic, generator.ToString(), generator.coefs[generator.deg])
+		return
+	}
+	if !simple.IsIrreducible(generator) {
+		err = fmt.Errorf("%w: %s over %s", ErrGeneratorReducible, generator.ToString(), simple.ToString())
+		return
+	}
+
+	if m == 1 {
+		field = simple
+		return
+	}
+	field = ExtendedField{simple, p, m, generator, enableLogging}
+	return
+}
+
+// NewField создаёт поле GF(p^m), автоматически выбирая порождающий многочлен.
+// Выбирается лексикографически наименьший (по коэффициентам, начиная со старшего)
+// нормированный неприводимый многочлен степени m, поэтому результат детерминирован.
+func NewField(p, m int) (FieldInterface, error) {
+	if p < 2 || m < 1 {
+		return nil, fmt.Errorf("%w: p=%d < 2 or m=%d < 1", ErrInvalidParameters, p, m)
+	}
+	if !IsPrime(p) {
+		return nil, fmt.Errorf("%w: p=%d", ErrNotPrime, p)
+	}
+	if m == 1 {
+		return SimpleField{p, false}, nil
+	}
+
+	simple := SimpleField{p, false}
+	generator, err := smallestIrreducible(simple, m)
+	if err != nil {
+		return nil, err
+	}
+	return ExtendedField{simple, p, m, generator, false}, nil
+}
+
+// smallestIrreducible перебирает нормированные многочлены степени m в порядке
+// возрастания и возвращает первый неприводимый.
+func smallestIrreducible(simpleField SimpleField, m int) (Polynomial, error) {
+	total := new(big.Int).Exp(big.NewInt(int64(simpleField.p)), big.NewInt(int64(m)), nil)
+	limit := int64(math.MaxInt64)
+	if total.IsInt64() {
+		limit = total.Int64()
+	}
+
+	for i := int64(0); i < limit; i++ {
+		comb, err := nthCombination(simpleField.p, m, i)
+		if err != nil {
+			return newZeroPolynomial(), err
+		}
+		// comb хранит коэффициенты начиная со старшего, добавляем старший член x^m
+		poly := NewPolynomial(append([]int{1}, comb...))
+		if poly.coefs[0] == 0 {
+			continue
+		}
+		if simpleField.IsIrreducible(poly) {
+			return poly, nil
+		}
+	}
+	return newZeroPolynomial(), fmt.Errorf("no irreducible polynomial of degree %d over %s", m, simpleField.ToString())
+}
+
 // Представление конечного поля GF(p)
 type SimpleField struct {
 	p             int
@@ -87,11 +193,33 @@ func (f SimpleField) SubPolynomials(p1, p2 Polynomial) (product Polynomial) {
 }
 
 func (f SimpleField) MulPolynomials(p1, p2 Polynomial) (product Polynomial) {
-	product = f.Normalize(p1.Mul(p2))
+	p1, p2 = f.Normalize(p1), f.Normalize(p2)
+	if p1.isZeroPolynomial() || p2.isZeroPolynomial() {
+		return newZeroPolynomial()
+	}
+
+	// Коэффициенты уже приведены в [0, p), поэтому умножаем точно по модулю p
+	product = newPolynomialNoReverse(fromUint64(mulCoefs(toUint64(p1.coefs), toUint64(p2.coefs), uint64(f.p))))
 	return
 }
 
+// DivPolynomials выполняет деление с остатком. Для больших степеней делителя и частного
+// используется деление через итерацию Ньютона, иначе - деление столбиком.
 func (f SimpleField) DivPolynomials(p1, p2 Polynomial) (quot, rem Polynomial, err error) {
+	if p2.isZeroPolynomial() {
+		err := fmt.Errorf("division by zero is not supported")
+		tryLog(f.enableLogging, err)
+		return newZeroPolynomial(), newZeroPolynomial(), err
+	}
+	if n1, n2 := f.Normalize(p1), f.Normalize(p2); n2.deg >= newtonDivisionThreshold && n1.deg-n2.deg >= newtonDivisionThreshold {
+		quot, rem = f.newtonDivision(n1, n2)
+		return
+	}
+	return f.longDivision(p1, p2)
+}
+
+// longDivision выполняет деление столбиком.
+func (f SimpleField) longDivision(p1, p2 Polynomial) (quot, rem Polynomial, err error) {
 	q := []int{}
 	r := make([]int, p1.len)
 	copy(r, reverse(p1.coefs))
@@ -108,6 +236,14 @@ func (f SimpleField) DivPolynomials(p1, p2 Polynomial) (quot, rem Polynomial, er
 		return newZeroPolynomial(), f.Normalize(p1), nil
 	}
 
+	// Приводим коэффициенты в [0, p), чтобы произведения считались без переполнения
+	for i := range r {
+		r[i] = reduceInt(r[i], f.p)
+	}
+	for i := range d {
+		d[i] = reduceInt(d[i], f.p)
+	}
+
 	// Находим коэффициент для вычитания
 	inv := modInverse(d[0], f.p)
 	if inv == -1 {
@@ -116,19 +252,19 @@ func (f SimpleField) DivPolynomials(p1, p2 Polynomial) (quot, rem Polynomial, er
 		return newZeroPolynomial(), newZeroPolynomial(), err
 	}
 
-	for len(r) >= len(d) && !isZero(r) {
-		leadCoeff := (r[0] * inv) % f.p
-		if leadCoeff < 0 {
-			leadCoeff += f.p
+	p := uint64(f.p)
+	for len(r) >= len(d) {
+		if isZero(r) {
+			// Остаток обнулился: оставшиеся коэффициенты частного нулевые
+			q = append(q, make([]int, len(r)-len(d)+1)...)
+			break
 		}
+		leadCoeff := int(mulMod(uint64(r[0]), uint64(inv), p))
 		q = append(q, leadCoeff)
 
 		// Вычитаем (leadCoeff * b) из r
 		for i := 0; i < len(d); i++ {
-			r[i] = (r[i] - leadCoeff*d[i]) % f.p
-			if r[i] < 0 {
-				r[i] += f.p
-			}
+			r[i] = int(subMod(uint64(r[i]), mulMod(uint64(leadCoeff), uint64(d[i]), p), p))
 		}
 		// Удаляем старший член
 		r = r[1:]
@@ -139,6 +275,37 @@ func (f SimpleField) DivPolynomials(p1, p2 Polynomial) (quot, rem Polynomial, er
 	return
 }
 
+// InvPolynomial возвращает обратный элемент GF(p) для многочлена нулевой степени.
+func (f SimpleField) InvPolynomial(poly Polynomial) (Polynomial, error) {
+	poly = f.Normalize(poly)
+	if poly.deg != 0 {
+		err := fmt.Errorf("polynomial %s is not a nonzero element of %s", poly.ToString(), f.ToString())
+		tryLog(f.enableLogging, err)
+		return newZeroPolynomial(), err
+	}
+
+	inv := modInverse(poly.coefs[0], f.p)
+	if inv == -1 {
+		err := fmt.Errorf("there is no reverse element")
+		tryLog(f.enableLogging, err)
+		return newZeroPolynomial(), err
+	}
+
+	return newPolynomialNoReverse([]int{inv}), nil
+}
+
+// Eval вычисляет значение многочлена в точке x поля GF(p) по схеме Горнера.
+func (f SimpleField) Eval(poly Polynomial, x int) int {
+	poly = f.Normalize(poly)
+	pu := uint64(f.p)
+	xu := uint64(reduceInt(x, f.p))
+	var result uint64
+	for i := poly.deg; i >= 0; i-- {
+		result = addMod(mulMod(result, xu, pu), uint64(poly.coefs[i]), pu)
+	}
+	return int(result)
+}
+
 // PowModPolynomial выполняет быстрое возведение в степень многочлена base в степени exp по модулю mod.
 // Параметры:
 // - base: Многочлен, который нужно возводить в степень.
@@ -147,22 +314,26 @@ func (f SimpleField) DivPolynomials(p1, p2 Polynomial) (quot, rem Polynomial, er
 // Возвращает:
 // - Результат вычисления base^exp % mod.
 func (f SimpleField) PowModPolynomial(base Polynomial, exp int, mod Polynomial) Polynomial {
+	// Делитель подготавливаем один раз: все приведения по модулю сводятся к умножениям
+	modulus, err := f.NewModulus(mod)
+	if err != nil {
+		return newZeroPolynomial()
+	}
+
 	// Результат инициализируем как единичный многочлен [1]
 	result := newPolynomialNoReverse([]int{1})
 
 	// Копируем base для работы, чтобы не изменять исходный многочлен
-	_, currentBase, _ := f.DivPolynomials(base, mod)
+	currentBase := modulus.Reduce(base)
 
 	for exp > 0 {
 		if exp%2 == 1 {
 			// Если текущий бит степени exp равен 1, умножаем результат на currentBase
-			result = f.MulPolynomials(result, currentBase)
-			_, result, _ = f.DivPolynomials(result, mod) // Берем остаток от деления
+			result = modulus.Reduce(f.MulPolynomials(result, currentBase)) // Берем остаток от деления
 		}
 
 		// Возводим currentBase в квадрат
-		currentBase = f.MulPolynomials(currentBase, currentBase)
-		_, currentBase, _ = f.DivPolynomials(currentBase, mod) // Берем остаток от деления
+		currentBase = modulus.Reduce(f.MulPolynomials(currentBase, currentBase)) // Берем остаток от деления
 
 		// Переходим к следующему биту
 		exp /= 2
@@ -173,6 +344,26 @@ func (f SimpleField) PowModPolynomial(base Polynomial, exp int, mod Polynomial)
 
 // GenerateIrreduciblePolynomials генерирует все комбинации длины k из диапазона [0..n-1] с повторениями.
 func GenerateIrreduciblePolynomials(simpleField SimpleField, length, workers, totalCount int) (<-chan Polynomial, error) {
+	return generatePolynomials(simpleField, length, workers, totalCount, simpleField.IsIrreducible)
+}
+
+// GeneratePrimitivePolynomials аналогична GenerateIrreduciblePolynomials, но возвращает
+// только примитивные многочлены длины length (степени length-1).
+func GeneratePrimitivePolynomials(simpleField SimpleField, length, workers, totalCount int) (<-chan Polynomial, error) {
+	order, err := multiplicativeOrder(simpleField.p, length-1)
+	if err != nil {
+		return nil, err
+	}
+	factors := primeFactors(order)
+
+	return generatePolynomials(simpleField, length, workers, totalCount, func(poly Polynomial) bool {
+		return simpleField.isPrimitive(poly, order, factors)
+	})
+}
+
+// generatePolynomials перебирает нормированные многочлены длины length с ненулевым
+// свободным членом и отправляет в канал те, что удовлетворяют условию accept.
+func generatePolynomials(simpleField SimpleField, length, workers, totalCount int, accept func(Polynomial) bool) (<-chan Polynomial, error) {
 	prime := simpleField.p
 	if prime < 0 || length < 0 {
 		return nil, errors.New("n и k должны быть неотрицательными")
@@ -246,7 +437,7 @@ func GenerateIrreduciblePolynomials(simpleField SimpleField, length, workers, to
 					continue
 				}
 				poly := Polynomial{comb, length, length - 1}
-				if !(simpleField.IsIrreducible(poly)) {
+				if !accept(poly) {
 					continue
 				}
 				if totalCount == -1 {
@@ -304,10 +495,12 @@ func (f SimpleField) IsIrreducible(poly Polynomial) bool {
 		return true
 	}
 
+	// x^(p^i) получаем последовательным возведением в степень p, чтобы p^i не переполняло int
 	x := newPolynomialNoReverse([]int{0, 1}) // x
+	h := x
 	for m, i := n/2, 1; i <= m; i++ {
-		tmp := f.PowModPolynomial(x, int(math.Pow(float64(f.p), float64(i))), poly)
-		tmp = f.SubPolynomials(tmp, x)
+		h = f.PowModPolynomial(h, f.p, poly)
+		tmp := f.SubPolynomials(h, x)
 		if f.GCD(poly, tmp).deg > 0 {
 			return false
 		}
@@ -315,6 +508,47 @@ func (f SimpleField) IsIrreducible(poly Polynomial) bool {
 	return true
 }
 
+// IsPrimitive проверяет, что многочлен неприводим и x имеет по его модулю
+// порядок p^m - 1, где m - степень многочлена.
+func (f SimpleField) IsPrimitive(poly Polynomial) bool {
+	poly = f.Normalize(poly)
+	order, err := multiplicativeOrder(f.p, poly.deg)
+	if err != nil {
+		tryLog(f.enableLogging, err)
+		return false
+	}
+	return f.isPrimitive(poly, order, primeFactors(order))
+}
+
+// isPrimitive проверяет примитивность при заранее найденных простых делителях порядка.
+func (f SimpleField) isPrimitive(poly Polynomial, order int, factors []int) bool {
+	if poly.deg < 1 || poly.coefs[0]%f.p == 0 || !f.IsIrreducible(poly) {
+		return false
+	}
+
+	x := newPolynomialNoReverse([]int{0, 1})
+	one := newPolynomialNoReverse([]int{1})
+	for _, r := range factors {
+		if f.PowModPolynomial(x, order/r, poly).Equals(one) {
+			return false
+		}
+	}
+	return true
+}
+
+// multiplicativeOrder возвращает порядок мультипликативной группы GF(p^m), то есть p^m - 1.
+func multiplicativeOrder(p, m int) (int, error) {
+	if m < 1 {
+		return 0, fmt.Errorf("invalid degree m=%d < 1", m)
+	}
+	q := new(big.Int).Exp(big.NewInt(int64(p)), big.NewInt(int64(m)), nil)
+	q.Sub(q, big.NewInt(1))
+	if !q.IsInt64() {
+		return 0, errors.New("the value of p^m is too large for processing")
+	}
+	return int(q.Int64()), nil
+}
+
 func (sf SimpleField) GCD(p1, p2 Polynomial) Polynomial {
 	for !(p2.isZeroPolynomial()) {
 		_, mod, _ := sf.DivPolynomials(p1, p2)
@@ -323,6 +557,27 @@ func (sf SimpleField) GCD(p1, p2 Polynomial) Polynomial {
 	return p1
 }
 
+// ExtendedGCD возвращает нормированный НОД g многочленов a и b вместе с коэффициентами
+// Безу s и t, для которых s*a + t*b = g. Если оба многочлена нулевые, все три результата нулевые.
+func (f SimpleField) ExtendedGCD(a, b Polynomial) (g, s, t Polynomial) {
+	r0, r1 := f.Normalize(a), f.Normalize(b)
+	s0, s1 := newPolynomialNoReverse([]int{1}), newZeroPolynomial()
+	t0, t1 := newZeroPolynomial(), newPolynomialNoReverse([]int{1})
+
+	for !r1.isZeroPolynomial() {
+		quot, rem, _ := f.DivPolynomials(r0, r1)
+		r0, r1 = r1, rem
+		s0, s1 = s1, f.SubPolynomials(s0, f.MulPolynomials(quot, s1))
+		t0, t1 = t1, f.SubPolynomials(t0, f.MulPolynomials(quot, t1))
+	}
+	if r0.isZeroPolynomial() {
+		return newZeroPolynomial(), newZeroPolynomial(), newZeroPolynomial()
+	}
+
+	inv := newPolynomialNoReverse([]int{modInverse(r0.coefs[r0.deg], f.p)})
+	return f.MulPolynomials(r0, inv), f.MulPolynomials(s0, inv), f.MulPolynomials(t0, inv)
+}
+
 func (f SimpleField) ToString() string {
 	return fmt.Sprintf("GF(%d)", f.p)
 }
@@ -365,7 +620,7 @@ func (f ExtendedField) SubPolynomials(p1, p2 Polynomial) (product Polynomial) {
 }
 
 func (f ExtendedField) MulPolynomials(p1, p2 Polynomial) (product Polynomial) {
-	product = f.Normalize(p1.Mul(p2))
+	product = f.Normalize(f.simple.MulPolynomials(p1, p2))
 	return
 }
 
@@ -378,6 +633,10 @@ func (f ExtendedField) DivPolynomials(p1, p2 Polynomial) (Polynomial, Polynomial
 	return newZeroPolynomial(), f.MulPolynomials(p1, inverse), nil
 }
 
+func (f ExtendedField) InvPolynomial(poly Polynomial) (Polynomial, error) {
+	return f.modInverse(poly)
+}
+
 func (f ExtendedField) modInverse(poly Polynomial) (Polynomial, error) {
 	if poly.deg >= f.generator.deg {
 		_, poly, _ = f.simple.DivPolynomials(poly, f.generator)
@@ -388,9 +647,14 @@ func (f ExtendedField) modInverse(poly Polynomial) (Polynomial, error) {
 		return newZeroPolynomial(), err
 	}
 
-	q := int(math.Pow(float64(f.p), float64(f.generator.deg)))
+	g, s, _ := f.simple.ExtendedGCD(poly, f.generator)
+	if g.deg != 0 {
+		err := fmt.Errorf("polynomial %s is not invertible modulo %s", poly.ToString(), f.generator.ToString())
+		tryLog(f.enableLogging, err)
+		return newZeroPolynomial(), err
+	}
 
-	return f.simple.PowModPolynomial(poly, q-2, f.generator), nil
+	return s, nil
 }
 
 func (ex ExtendedField) IsIrreducible(poly Polynomial) bool {
@@ -0,0 +1,372 @@
+package polygfgo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// kroneckerThreshold - степень сомножителей, начиная с которой многочлены над полем
+// перемножаются подстановкой Кронекера вместо умножения столбиком.
+const kroneckerThreshold = 16
+
+// kroneckerField реализуют поля, элементы которых - многочлены от одной переменной над GF(p),
+// приводимые по модулю порождающего многочлена в AddPolynomials. Только для таких полей
+// произведение упакованных коэффициентов даёт произведения элементов, и применима kroneckerMul.
+type kroneckerField interface {
+	kroneckerPackable()
+}
+
+func (f SimpleField) kroneckerPackable()   {}
+func (f ExtendedField) kroneckerPackable() {}
+
+// FieldPolynomial - многочлен из кольца GF(q)[x], коэффициенты которого являются
+// элементами поля field (в том числе расширенного поля GF(p^m)).
+// Коэффициенты хранятся от младшего к старшему, старший коэффициент ненулевой.
+type FieldPolynomial struct {
+	field FieldInterface
+	coefs []Element
+	deg   int
+}
+
+// NewFieldPolynomial создаёт многочлен над полем field.
+// Как и в NewPolynomial, коэффициенты передаются начиная со старшего.
+func NewFieldPolynomial(field FieldInterface, coefs []Element) (FieldPolynomial, error) {
+	result := make([]Element, len(coefs))
+	for i, c := range coefs {
+		if !sameField(field, c.field) {
+			return newZeroFieldPolynomial(field), fmt.Errorf("coefficient %s does not belong to %s", c.ToString(), field.ToString())
+		}
+		result[len(coefs)-1-i] = c
+	}
+	return newFieldPolynomialNoReverse(field, result), nil
+}
+
+func newFieldPolynomialNoReverse(field FieldInterface, coefs []Element) FieldPolynomial {
+	i := len(coefs) - 1
+	for ; i >= 0; i-- {
+		if !coefs[i].IsZero() {
+			break
+		}
+	}
+
+	result := make([]Element, i+1)
+	copy(result, coefs[:i+1])
+
+	return FieldPolynomial{field, result, i}
+}
+
+func newZeroFieldPolynomial(field FieldInterface) FieldPolynomial {
+	return FieldPolynomial{field, []Element{}, -1}
+}
+
+// newMonomialFieldPolynomial возвращает c*x^n.
+func newMonomialFieldPolynomial(c Element, n int) FieldPolynomial {
+	coefs := make([]Element, n+1)
+	for i := range coefs {
+		coefs[i] = ZeroElement(c.field)
+	}
+	coefs[n] = c
+	return newFieldPolynomialNoReverse(c.field, coefs)
+}
+
+func (fp FieldPolynomial) Field() FieldInterface {
+	return fp.field
+}
+
+func (fp FieldPolynomial) GetDegree() int {
+	return fp.deg
+}
+
+func (fp FieldPolynomial) IsZero() bool {
+	return fp.deg == -1
+}
+
+// Coefficient возвращает коэффициент при x^i.
+func (fp FieldPolynomial) Coefficient(i int) Element {
+	if i < 0 || i > fp.deg {
+		return ZeroElement(fp.field)
+	}
+	return fp.coefs[i]
+}
+
+func (fp FieldPolynomial) LeadingCoefficient() Element {
+	return fp.Coefficient(fp.deg)
+}
+
+func (fp FieldPolynomial) Equals(other FieldPolynomial) bool {
+	if !sameField(fp.field, other.field) || fp.deg != other.deg {
+		return false
+	}
+	for i := 0; i <= fp.deg; i++ {
+		if !fp.coefs[i].Equal(other.coefs[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fp FieldPolynomial) Add(other FieldPolynomial) FieldPolynomial {
+	mustSameFieldPolynomial(fp, other)
+	n := max(fp.deg, other.deg) + 1
+	coefs := make([]Element, n)
+	for i := 0; i < n; i++ {
+		coefs[i] = fp.Coefficient(i).Add(other.Coefficient(i))
+	}
+	return newFieldPolynomialNoReverse(fp.field, coefs)
+}
+
+func (fp FieldPolynomial) Sub(other FieldPolynomial) FieldPolynomial {
+	mustSameFieldPolynomial(fp, other)
+	n := max(fp.deg, other.deg) + 1
+	coefs := make([]Element, n)
+	for i := 0; i < n; i++ {
+		coefs[i] = fp.Coefficient(i).Sub(other.Coefficient(i))
+	}
+	return newFieldPolynomialNoReverse(fp.field, coefs)
+}
+
+func (fp FieldPolynomial) Mul(other FieldPolynomial) FieldPolynomial {
+	mustSameFieldPolynomial(fp, other)
+	if fp.IsZero() || other.IsZero() {
+		return newZeroFieldPolynomial(fp.field)
+	}
+	if _, packable := fp.field.(kroneckerField); packable && min(fp.deg, other.deg) >= kroneckerThreshold {
+		return fp.kroneckerMul(other)
+	}
+
+	coefs := make([]Element, fp.deg+other.deg+1)
+	for i := range coefs {
+		coefs[i] = ZeroElement(fp.field)
+	}
+	for i, a := range fp.coefs {
+		if a.IsZero() {
+			continue
+		}
+		for j, b := range other.coefs {
+			coefs[i+j] = coefs[i+j].Add(a.Mul(b))
+		}
+	}
+	return newFieldPolynomialNoReverse(fp.field, coefs)
+}
+
+func (fp FieldPolynomial) MulScalar(alpha Element) FieldPolynomial {
+	coefs := make([]Element, len(fp.coefs))
+	for i, c := range fp.coefs {
+		coefs[i] = c.Mul(alpha)
+	}
+	return newFieldPolynomialNoReverse(fp.field, coefs)
+}
+
+// DivMod выполняет деление с остатком: fp = quot*divisor + rem, deg rem < deg divisor.
+func (fp FieldPolynomial) DivMod(divisor FieldPolynomial) (quot, rem FieldPolynomial, err error) {
+	mustSameFieldPolynomial(fp, divisor)
+	if divisor.IsZero() {
+		err = fmt.Errorf("division by zero is not supported")
+		return newZeroFieldPolynomial(fp.field), newZeroFieldPolynomial(fp.field), err
+	}
+	if fp.deg < divisor.deg {
+		return newZeroFieldPolynomial(fp.field), fp, nil
+	}
+
+	inv, err := divisor.LeadingCoefficient().Inv()
+	if err != nil {
+		return newZeroFieldPolynomial(fp.field), newZeroFieldPolynomial(fp.field), err
+	}
+	if divisor.deg >= newtonDivisionThreshold && fp.deg-divisor.deg >= newtonDivisionThreshold {
+		quot, rem = fp.newtonDivMod(divisor)
+		return
+	}
+
+	r := make([]Element, len(fp.coefs))
+	copy(r, fp.coefs)
+	q := make([]Element, fp.deg-divisor.deg+1)
+	for k := fp.deg - divisor.deg; k >= 0; k-- {
+		lead := r[k+divisor.deg].Mul(inv)
+		q[k] = lead
+		if lead.IsZero() {
+			continue
+		}
+		for i, d := range divisor.coefs {
+			r[k+i] = r[k+i].Sub(lead.Mul(d))
+		}
+	}
+
+	quot = newFieldPolynomialNoReverse(fp.field, q)
+	rem = newFieldPolynomialNoReverse(fp.field, r[:divisor.deg])
+	return
+}
+
+// Monic делит многочлен на старший коэффициент. Нулевой многочлен возвращается без изменений.
+func (fp FieldPolynomial) Monic() FieldPolynomial {
+	if fp.IsZero() {
+		return fp
+	}
+	inv, _ := fp.LeadingCoefficient().Inv()
+	return fp.MulScalar(inv)
+}
+
+// GCD возвращает нормированный (со старшим коэффициентом 1) НОД двух многочленов.
+func (fp FieldPolynomial) GCD(other FieldPolynomial) FieldPolynomial {
+	mustSameFieldPolynomial(fp, other)
+	a, b := fp, other
+	for !b.IsZero() {
+		_, r, _ := a.DivMod(b)
+		a, b = b, r
+	}
+	return a.Monic()
+}
+
+// ExtendedGCD возвращает нормированный НОД g и коэффициенты Безу s, t: s*fp + t*other = g.
+func (fp FieldPolynomial) ExtendedGCD(other FieldPolynomial) (g, s, t FieldPolynomial) {
+	mustSameFieldPolynomial(fp, other)
+	r0, r1 := fp, other
+	s0, s1 := newMonomialFieldPolynomial(OneElement(fp.field), 0), newZeroFieldPolynomial(fp.field)
+	t0, t1 := newZeroFieldPolynomial(fp.field), newMonomialFieldPolynomial(OneElement(fp.field), 0)
+
+	for !r1.IsZero() {
+		quot, rem, _ := r0.DivMod(r1)
+		r0, r1 = r1, rem
+		s0, s1 = s1, s0.Sub(quot.Mul(s1))
+		t0, t1 = t1, t0.Sub(quot.Mul(t1))
+	}
+	if r0.IsZero() {
+		return r0, newZeroFieldPolynomial(fp.field), newZeroFieldPolynomial(fp.field)
+	}
+
+	inv, _ := r0.LeadingCoefficient().Inv()
+	return r0.MulScalar(inv), s0.MulScalar(inv), t0.MulScalar(inv)
+}
+
+// IsIrreducible проверяет неприводимость над полем коэффициентов GF(q) тестом Рабина:
+// x^(q^n) = x mod fp и gcd(x^(q^(n/r)) - x, fp) = 1 для всех простых r | n.
+func (fp FieldPolynomial) IsIrreducible() bool {
+	if fp.deg < 1 {
+		return false
+	}
+	if fp.deg == 1 {
+		return true
+	}
+
+	poly := fp.Monic()
+	x := newMonomialFieldPolynomial(OneElement(fp.field), 1)
+	checks := map[int]bool{}
+	for _, r := range primeFactors(poly.deg) {
+		checks[poly.deg/r] = true
+	}
+
+	h := x
+	for i := 1; i <= poly.deg; i++ {
+		h = h.powQ(poly)
+		if checks[i] && poly.GCD(h.Sub(x)).deg > 0 {
+			return false
+		}
+	}
+	_, rem, _ := h.Sub(x).DivMod(poly)
+	return rem.IsZero()
+}
+
+// Derivative возвращает формальную производную многочлена.
+func (fp FieldPolynomial) Derivative() FieldPolynomial {
+	if fp.deg < 1 {
+		return newZeroFieldPolynomial(fp.field)
+	}
+
+	coefs := make([]Element, fp.deg)
+	for i := 1; i <= fp.deg; i++ {
+		coefs[i-1] = fp.coefs[i].Mul(NewElementFromInt(fp.field, i))
+	}
+	return newFieldPolynomialNoReverse(fp.field, coefs)
+}
+
+// Eval вычисляет значение многочлена в точке x по схеме Горнера.
+func (fp FieldPolynomial) Eval(x Element) Element {
+	result := ZeroElement(fp.field)
+	for i := fp.deg; i >= 0; i-- {
+		result = result.Mul(x).Add(fp.coefs[i])
+	}
+	return result
+}
+
+func (fp FieldPolynomial) ToString() string {
+	parts := make([]string, 0, len(fp.coefs))
+	for i := fp.deg; i >= 0; i-- {
+		parts = append(parts, fp.coefs[i].value.ToString())
+	}
+	return "[" + strings.Join(parts, " ") + "]"
+}
+
+// kroneckerMul сводит умножение к одному умножению над GF(p): коэффициент при x^i
+// записывается начиная с позиции i*(2m-1), и слагаемые произведения не перекрываются.
+// Поле должно реализовывать kroneckerField; например, элементы башен полей записаны
+// в развёрнутом виде, и их произведение так не вычисляется.
+func (fp FieldPolynomial) kroneckerMul(other FieldPolynomial) FieldPolynomial {
+	stride := 2*fp.field.GetDegree() - 1
+	base := SimpleField{fp.field.GetPrime(), false}
+	product := base.MulPolynomials(fp.pack(stride), other.pack(stride))
+
+	coefs := make([]Element, fp.deg+other.deg+1)
+	chunk := make([]int, stride)
+	for i := range coefs {
+		for j := range chunk {
+			chunk[j] = coefAt(product, i*stride+j)
+		}
+		coefs[i] = Element{fp.field, fp.field.AddPolynomials(newPolynomialNoReverse(chunk), newZeroPolynomial())}
+	}
+	return newFieldPolynomialNoReverse(fp.field, coefs)
+}
+
+// pack записывает коэффициенты подряд в один многочлен над GF(p), отводя каждому stride позиций.
+func (fp FieldPolynomial) pack(stride int) Polynomial {
+	coefs := make([]int, (fp.deg+1)*stride)
+	for i, c := range fp.coefs {
+		copy(coefs[i*stride:], c.value.coefs[:c.value.deg+1])
+	}
+	return newPolynomialNoReverse(coefs)
+}
+
+// newtonDivMod делит через обращение развёрнутого делителя, как SimpleField.newtonDivision.
+func (fp FieldPolynomial) newtonDivMod(divisor FieldPolynomial) (quot, rem FieldPolynomial) {
+	k := fp.deg - divisor.deg + 1
+	inverse := divisor.reverse(divisor.deg + 1).invSeries(k)
+	revQuot := fp.reverse(fp.deg + 1).truncate(k).Mul(inverse).truncate(k)
+
+	quot = revQuot.reverse(k)
+	rem = fp.Sub(quot.Mul(divisor)).truncate(divisor.deg)
+	return
+}
+
+// invSeries возвращает g с fp*g = 1 mod x^n; свободный член fp должен быть ненулевым.
+func (fp FieldPolynomial) invSeries(n int) FieldPolynomial {
+	c, _ := fp.Coefficient(0).Inv()
+	g := newMonomialFieldPolynomial(c, 0)
+	two := newMonomialFieldPolynomial(NewElementFromInt(fp.field, 2), 0)
+	for precision := 1; precision < n; {
+		precision = min(2*precision, n)
+		e := fp.truncate(precision).Mul(g).truncate(precision)
+		g = g.Mul(two.Sub(e)).truncate(precision)
+	}
+	return g
+}
+
+// reverse возвращает x^(n-1) * fp(1/x).
+func (fp FieldPolynomial) reverse(n int) FieldPolynomial {
+	coefs := make([]Element, n)
+	for i := range coefs {
+		coefs[n-1-i] = fp.Coefficient(i)
+	}
+	return newFieldPolynomialNoReverse(fp.field, coefs)
+}
+
+// truncate возвращает fp mod x^n.
+func (fp FieldPolynomial) truncate(n int) FieldPolynomial {
+	if fp.deg < n {
+		return fp
+	}
+	return newFieldPolynomialNoReverse(fp.field, fp.coefs[:n])
+}
+
+func mustSameFieldPolynomial(p1, p2 FieldPolynomial) {
+	if !sameField(p1.field, p2.field) {
+		panic(fmt.Sprintf("polynomials belong to different rings: %s[x] and %s[x]", p1.field.ToString(), p2.field.ToString()))
+	}
+}
@@ -0,0 +1,205 @@
+package polygfgo
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// GF(2^8) mod x^8 + x^4 + x^3 + x + 1
+func newAESField() ExtendedField {
+	return ExtendedField{SimpleField{2, false}, 2, 8, NewPolynomial([]int{1, 0, 0, 0, 1, 1, 0, 1, 1}), false}
+}
+
+// byteElement переводит байт в элемент GF(2^8): i-й бит - коэффициент при x^i.
+func byteElement(f FieldInterface, b int) Element {
+	coefs := make([]int, 8)
+	for i := 0; i < 8; i++ {
+		coefs[i] = (b >> i) & 1
+	}
+	e, _ := NewElement(f, newPolynomialNoReverse(coefs))
+	return e
+}
+
+func byteFieldPolynomial(f FieldInterface, bytes ...int) FieldPolynomial {
+	coefs := make([]Element, len(bytes))
+	for i, b := range bytes {
+		coefs[i] = byteElement(f, b)
+	}
+	fp, _ := NewFieldPolynomial(f, coefs)
+	return fp
+}
+
+func TestFieldPolynomial_Arithmetic(t *testing.T) {
+	f := newAESField()
+
+	t.Run("addition in characteristic 2 cancels equal terms", func(t *testing.T) {
+		p1 := byteFieldPolynomial(f, 0x53, 0x10, 0x01)
+		p2 := byteFieldPolynomial(f, 0x53, 0x01, 0x01)
+
+		got := p1.Add(p2)
+		want := byteFieldPolynomial(f, 0x11, 0x00)
+
+		if !got.Equals(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+	})
+
+	t.Run("multiplication uses field arithmetic for coefficients", func(t *testing.T) {
+		p1 := byteFieldPolynomial(f, 0x53, 0x00)
+		p2 := byteFieldPolynomial(f, 0xCA)
+
+		got := p1.Mul(p2)
+		want := byteFieldPolynomial(f, 0x01, 0x00)
+
+		if !got.Equals(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+	})
+
+	t.Run("division with remainder", func(t *testing.T) {
+		a := byteFieldPolynomial(f, 0x12, 0x34, 0x56, 0x78, 0x9A)
+		b := byteFieldPolynomial(f, 0x0F, 0x02, 0xE1)
+
+		quot, rem, err := a.DivMod(b)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if rem.GetDegree() >= b.GetDegree() {
+			t.Errorf("Remainder %s has degree not lower than %d", rem.ToString(), b.GetDegree())
+		}
+		if got := quot.Mul(b).Add(rem); !got.Equals(a) {
+			t.Errorf("Expected %s but got %s", a.ToString(), got.ToString())
+		}
+	})
+
+	t.Run("division by zero polynomial", func(t *testing.T) {
+		a := byteFieldPolynomial(f, 0x12, 0x34)
+
+		if _, _, err := a.DivMod(newZeroFieldPolynomial(f)); err == nil {
+			t.Errorf("Expected error when dividing by zero in %s[x]", f.ToString())
+		}
+	})
+}
+
+func TestFieldPolynomial_GCD(t *testing.T) {
+	f := newAESField()
+
+	t.Run("GCD of polynomials with a common root", func(t *testing.T) {
+		common := byteFieldPolynomial(f, 0x01, 0x1D)
+		p1 := common.Mul(byteFieldPolynomial(f, 0x03, 0x07))
+		p2 := common.Mul(byteFieldPolynomial(f, 0x05, 0x80, 0x02))
+
+		got := p1.GCD(p2)
+		want := common
+
+		if !got.Equals(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+	})
+}
+
+func TestFieldPolynomial_Eval(t *testing.T) {
+	f := newAESField()
+
+	t.Run("Reed-Solomon generator vanishes at its roots", func(t *testing.T) {
+		alpha := byteElement(f, 0x03)
+		g := byteFieldPolynomial(f, 0x01)
+		roots := []Element{}
+		power := OneElement(f)
+		for i := 0; i < 4; i++ {
+			power = power.Mul(alpha)
+			roots = append(roots, power)
+			linear, _ := NewFieldPolynomial(f, []Element{OneElement(f), power.Neg()})
+			g = g.Mul(linear)
+		}
+
+		for _, root := range roots {
+			if got := g.Eval(root); !got.IsZero() {
+				t.Errorf("Expected zero at %s but got %s", root.ToString(), got.ToString())
+			}
+		}
+		if got := g.Eval(OneElement(f)); got.IsZero() {
+			t.Errorf("Expected nonzero value at 1")
+		}
+	})
+}
+
+func TestFieldPolynomial_Derivative(t *testing.T) {
+	f := newAESField()
+
+	t.Run("even powers vanish in characteristic 2", func(t *testing.T) {
+		poly := byteFieldPolynomial(f, 0x05, 0x11, 0x22, 0x33)
+
+		got := poly.Derivative()
+		want := byteFieldPolynomial(f, 0x05, 0x00, 0x22)
+
+		if !got.Equals(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+	})
+}
+
+func TestFieldPolynomial_LargeDegree(t *testing.T) {
+	f := newAESField()
+	rng := rand.New(rand.NewSource(1))
+	randomPoly := func(n int) FieldPolynomial {
+		coefs := make([]Element, n)
+		for i := range coefs {
+			coefs[i] = byteElement(f, rng.Intn(256))
+		}
+		coefs[n-1] = OneElement(f)
+		return newFieldPolynomialNoReverse(f, coefs)
+	}
+
+	t.Run("Kronecker product agrees with evaluation", func(t *testing.T) {
+		a, b := randomPoly(40), randomPoly(50)
+		product := a.Mul(b)
+
+		for _, x := range []int{0x00, 0x02, 0x53, 0xFF} {
+			point := byteElement(f, x)
+			if got, want := product.Eval(point), a.Eval(point).Mul(b.Eval(point)); !got.Equal(want) {
+				t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+			}
+		}
+	})
+
+	t.Run("only univariate fields use Kronecker packing", func(t *testing.T) {
+		_, tower := newCompositeAESField(t)
+		cases := []struct {
+			field FieldInterface
+			want  bool
+		}{{f, true}, {&f, true}, {SimpleField{5, false}, true}, {tower, false}}
+		for _, c := range cases {
+			if _, got := c.field.(kroneckerField); got != c.want {
+				t.Errorf("Expected %t but got %t for %s", c.want, got, c.field.ToString())
+			}
+		}
+
+		coefs := make([]Element, 20)
+		for i := range coefs {
+			coefs[i] = randomElement(tower, rng)
+		}
+		a := newFieldPolynomialNoReverse(tower, coefs)
+		product := a.Mul(a)
+		for i := 0; i < 3; i++ {
+			point := randomElement(tower, rng)
+			if got, want := product.Eval(point), a.Eval(point).Mul(a.Eval(point)); !got.Equal(want) {
+				t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+			}
+		}
+	})
+
+	t.Run("Newton division recovers the factors", func(t *testing.T) {
+		a, b, c := randomPoly(150), randomPoly(100), randomPoly(60)
+		poly := a.Mul(b).Add(c)
+
+		quot, rem, err := poly.DivMod(b)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if !quot.Equals(a) || !rem.Equals(c) {
+			t.Errorf("Expected quotient %s and remainder %s", a.ToString(), c.ToString())
+		}
+	})
+}
@@ -1,6 +1,8 @@
 package polygfgo
 
 import (
+	"errors"
+	"fmt"
 	"math"
 	"testing"
 )
@@ -241,6 +243,25 @@ func TestSimpleField_Div(t *testing.T) {
 		}
 	})
 
+	t.Run("division with zero remainder and trailing zeros in quotient", func(t *testing.T) {
+		f := SimpleField{5, false}
+		poly1 := newPolynomialNoReverse([]int{0, 0, 1, 1})
+		poly2 := newPolynomialNoReverse([]int{1, 1})
+
+		gotQuot, gotRem, _ := f.DivPolynomials(poly1, poly2)
+		wantQuot := newPolynomialNoReverse([]int{0, 0, 1})
+		wantRem := newPolynomialNoReverse([]int{})
+
+		if !(gotQuot.Equals(wantQuot) && gotRem.Equals(wantRem)) {
+			t.Errorf(
+				"Expected %s, %s but got %s, %s in GF(%d)",
+				wantQuot.Sprint(), wantRem.Sprint(),
+				gotQuot.Sprint(), gotRem.Sprint(),
+				f.p,
+			)
+		}
+	})
+
 	t.Run("division with larger divisor", func(t *testing.T) {
 		f := SimpleField{3, false}
 		poly1 := newPolynomialNoReverse([]int{2, 1})
@@ -279,6 +300,25 @@ func TestSimpleField_Div(t *testing.T) {
 		}
 	})
 
+	t.Run("division with a prime close to 2^62", func(t *testing.T) {
+		f := SimpleField{4611686018427387847, false}
+		poly1 := newPolynomialNoReverse([]int{5, 4611686018427387846, 4611686018427387840})
+		poly2 := newPolynomialNoReverse([]int{1, 4611686018427387846})
+
+		gotQuot, gotRem, _ := f.DivPolynomials(poly1, poly2)
+		wantQuot := newPolynomialNoReverse([]int{8, 7})
+		wantRem := newPolynomialNoReverse([]int{4611686018427387844})
+
+		if !(gotQuot.Equals(wantQuot) && gotRem.Equals(wantRem)) {
+			t.Errorf(
+				"Expected %s, %s but got %s, %s in GF(%d)",
+				wantQuot.Sprint(), wantRem.Sprint(),
+				gotQuot.Sprint(), gotRem.Sprint(),
+				f.p,
+			)
+		}
+	})
+
 	t.Run("division by zero polynomial", func(t *testing.T) {
 		f := SimpleField{7, false}
 		poly1 := newPolynomialNoReverse([]int{3, 6, 2})
@@ -491,6 +531,52 @@ func TestGCD(t *testing.T) {
 	})
 }
 
+func TestExtendedGCD(t *testing.T) {
+	t.Run("Bezout coefficients of two reducible polynomials", func(t *testing.T) {
+		f := SimpleField{11, false}
+		p1 := Polynomial{[]int{7, 3, 5, 8, 7, 8}, 6, 5}
+		p2 := Polynomial{[]int{4, 0, 5, 3, 3, 9, 6}, 7, 6}
+
+		g, s, tt := f.ExtendedGCD(p1, p2)
+		want := f.monic(Polynomial{[]int{7, 0, 10}, 3, 2})
+		if !(g.Equals(want)) {
+			t.Errorf("Expected %s but got %s", want.ToString(), g.ToString())
+		}
+
+		combination := f.AddPolynomials(f.MulPolynomials(s, p1), f.MulPolynomials(tt, p2))
+		if !(combination.Equals(g)) {
+			t.Errorf("Expected %s but got %s", g.ToString(), combination.ToString())
+		}
+	})
+
+	t.Run("coprime polynomials over 2^61 - 1", func(t *testing.T) {
+		f := SimpleField{2305843009213693951, false}
+		p1 := NewPolynomial([]int{3, -5, 0, 7, 1})
+		p2 := NewPolynomial([]int{1000000000000, 0, 1})
+
+		g, s, tt := f.ExtendedGCD(p1, p2)
+		want := NewPolynomial([]int{1})
+		if !(g.Equals(want)) {
+			t.Errorf("Expected %s but got %s", want.ToString(), g.ToString())
+		}
+
+		combination := f.AddPolynomials(f.MulPolynomials(s, p1), f.MulPolynomials(tt, p2))
+		if !(combination.Equals(want)) {
+			t.Errorf("Expected %s but got %s", want.ToString(), combination.ToString())
+		}
+	})
+
+	t.Run("GCD with zero polynomial", func(t *testing.T) {
+		f := SimpleField{7, false}
+		p1 := NewPolynomial([]int{3, 1})
+
+		g, s, tt := f.ExtendedGCD(p1, newZeroPolynomial())
+		if !g.Equals(NewPolynomial([]int{1, 5})) || !s.Equals(NewPolynomial([]int{5})) || !tt.isZeroPolynomial() {
+			t.Errorf("Expected (x + 5, 5, 0) but got (%s, %s, %s)", g.ToString(), s.ToString(), tt.ToString())
+		}
+	})
+}
+
 func TestExtendedField_ModInverse(t *testing.T) {
 	t.Run("calculationg inverse element #1", func(t *testing.T) {
 		f := ExtendedField{
@@ -583,6 +669,25 @@ func TestExtendedField_ModInverse(t *testing.T) {
 			t.Errorf("Failed to find inverse for irreducible polynomial %s. Got %s", poly.Sprint(), inverse.Sprint())
 		}
 	})
+
+	t.Run("inverse in a field with p^m beyond int", func(t *testing.T) {
+		field, err := StrictFieldFactory(1000000007, 3, NewPolynomial([]int{1, 0, 1, 5}), false)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		f := field.(ExtendedField)
+		poly := NewPolynomial([]int{123456789, 987654321, 5})
+
+		inverse, err := f.modInverse(poly)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		checked := f.MulPolynomials(poly, inverse)
+
+		if !checked.Equals(Polynomial{[]int{1}, 1, 0}) {
+			t.Errorf("Failed to find inverse for %s. Got %s", poly.Sprint(), inverse.Sprint())
+		}
+	})
 }
 
 func BenchmarkSimpleField_IsIrreducible(b *testing.B) {
@@ -592,3 +697,181 @@ func BenchmarkSimpleField_IsIrreducible(b *testing.B) {
 		f.IsIrreducible(poly)
 	}
 }
+
+func TestNewField(t *testing.T) {
+	t.Run("field GF(2^3) uses x^3 + x + 1", func(t *testing.T) {
+		field, err := NewField(2, 3)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		got := field.GetIrreducible()
+		want := NewPolynomial([]int{1, 0, 1, 1})
+
+		if !got.Equals(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+	})
+
+	t.Run("field GF(2^8) uses the AES polynomial", func(t *testing.T) {
+		field, err := NewField(2, 8)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		got := field.GetIrreducible()
+		want := NewPolynomial([]int{1, 0, 0, 0, 1, 1, 0, 1, 1})
+
+		if !got.Equals(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+	})
+
+	t.Run("generator is irreducible and deterministic", func(t *testing.T) {
+		f1, _ := NewField(7, 4)
+		f2, _ := NewField(7, 4)
+
+		if !f1.GetIrreducible().Equals(f2.GetIrreducible()) {
+			t.Errorf("Expected equal generators but got %s and %s", f1.ToString(), f2.ToString())
+		}
+		if f1.GetIrreducible().GetDegree() != 4 || !f1.IsIrreducible(f1.GetIrreducible()) {
+			t.Errorf("Expected irreducible generator of degree 4 but got %s", f1.ToString())
+		}
+	})
+
+	t.Run("degree 1 gives a prime field", func(t *testing.T) {
+		field, _ := NewField(13, 1)
+
+		if _, ok := field.(SimpleField); !ok {
+			t.Errorf("Expected SimpleField but got %s", field.ToString())
+		}
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		if _, err := NewField(1, 3); err == nil {
+			t.Errorf("Expected error for p=1")
+		}
+	})
+}
+
+func TestStrictFieldFactory(t *testing.T) {
+	t.Run("valid extension field", func(t *testing.T) {
+		field, err := StrictFieldFactory(2, 8, NewPolynomial([]int{1, 0, 0, 0, 1, 1, 0, 1, 1}), false)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if _, ok := field.(ExtendedField); !ok {
+			t.Errorf("Expected ExtendedField but got %s", field.ToString())
+		}
+	})
+
+	t.Run("prime field without generator", func(t *testing.T) {
+		field, err := StrictFieldFactory(104729, 1, newZeroPolynomial(), false)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if _, ok := field.(SimpleField); !ok {
+			t.Errorf("Expected SimpleField but got %s", field.ToString())
+		}
+	})
+
+	cases := []struct {
+		name      string
+		p, m      int
+		generator Polynomial
+		want      error
+	}{
+		{"invalid parameters", 2, 0, newZeroPolynomial(), ErrInvalidParameters},
+		{"composite characteristic", 4, 2, NewPolynomial([]int{1, 1, 1}), ErrNotPrime},
+		{"generator of wrong degree", 2, 4, NewPolynomial([]int{1, 1, 1}), ErrGeneratorDegree},
+		{"missing generator for extension", 3, 2, newZeroPolynomial(), ErrGeneratorDegree},
+		{"generator is not monic", 5, 2, NewPolynomial([]int{2, 0, 1}), ErrGeneratorNotMonic},
+		{"reducible generator", 2, 2, NewPolynomial([]int{1, 0, 1}), ErrGeneratorReducible},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := StrictFieldFactory(c.p, c.m, c.generator, false)
+			if !errors.Is(err, c.want) {
+				t.Errorf("Expected %v but got %v", c.want, err)
+			}
+		})
+	}
+}
+
+func TestIsPrimitive(t *testing.T) {
+	t.Run("x^4 + x + 1 is primitive over GF(2)", func(t *testing.T) {
+		f := SimpleField{2, false}
+
+		got := f.IsPrimitive(NewPolynomial([]int{1, 0, 0, 1, 1}))
+		want := true
+
+		if got != want {
+			t.Errorf("Expected %t but got %t", want, got)
+		}
+	})
+
+	t.Run("irreducible x^4 + x^3 + x^2 + x + 1 is not primitive over GF(2)", func(t *testing.T) {
+		f := SimpleField{2, false}
+
+		got := f.IsPrimitive(NewPolynomial([]int{1, 1, 1, 1, 1}))
+		want := false
+
+		if got != want {
+			t.Errorf("Expected %t but got %t", want, got)
+		}
+	})
+
+	t.Run("reducible polynomial is not primitive", func(t *testing.T) {
+		f := SimpleField{7, false}
+
+		got := f.IsPrimitive(NewPolynomial([]int{1, 0, 6}))
+		want := false
+
+		if got != want {
+			t.Errorf("Expected %t but got %t", want, got)
+		}
+	})
+
+	t.Run("linear polynomial x - 3 is primitive over GF(7)", func(t *testing.T) {
+		f := SimpleField{7, false}
+
+		got := f.IsPrimitive(NewPolynomial([]int{1, 4}))
+		want := true
+
+		if got != want {
+			t.Errorf("Expected %t but got %t", want, got)
+		}
+	})
+}
+
+func TestGeneratePrimitive(t *testing.T) {
+	cases := []struct {
+		p, degree, want int
+	}{
+		{2, 4, 2},
+		{2, 8, 16},
+		{3, 4, 8},
+		{5, 3, 20},
+	}
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("primitive polynomials of degree %d over GF(%d)", c.degree, c.p), func(t *testing.T) {
+			f := SimpleField{c.p, false}
+
+			ch, err := GeneratePrimitivePolynomials(f, c.degree+1, 4, -1)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			got := 0
+			for poly := range ch {
+				if !f.IsIrreducible(poly) {
+					t.Errorf("Polynomial %s is not irreducible", poly.ToString())
+				}
+				got++
+			}
+
+			if got != c.want {
+				t.Errorf("Expected %d but got %d", c.want, got)
+			}
+		})
+	}
+}
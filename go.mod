@@ -1,5 +1,3 @@
 module github.com/untibullet/polygfgo
 
 go 1.22.1
-
-require github.com/mjibson/go-dsp v0.0.0-20180508042940-11479a337f12
@@ -0,0 +1,87 @@
+package polygfgo
+
+import (
+	"fmt"
+)
+
+// Isomorphism - изоморфизм между двумя представлениями поля GF(p^m) с разными порождающими
+// многочленами. Отображение линейно над GF(p) и хранится матрицами в обе стороны:
+// столбец j матрицы forward - координаты образа x^j в target.
+type Isomorphism struct {
+	source, target    ExtendedField
+	forward, backward Matrix
+}
+
+// NewIsomorphism строит изоморфизм source -> target: находит корень порождающего
+// многочлена source в target, записывает образы степеней x и обращает полученную матрицу.
+func NewIsomorphism(source, target ExtendedField) (Isomorphism, error) {
+	if source.p != target.p || source.m != target.m {
+		err := fmt.Errorf("%s and %s are not isomorphic", source.ToString(), target.ToString())
+		tryLog(source.enableLogging, err)
+		return Isomorphism{}, err
+	}
+
+	embedding, err := target.SubfieldEmbedding(source)
+	if err != nil {
+		return Isomorphism{}, err
+	}
+
+	m := source.m
+	entries := make([][]int, m)
+	for i := range entries {
+		entries[i] = make([]int, m)
+	}
+	power := newPolynomialNoReverse([]int{1})
+	for j := 0; j < m; j++ {
+		for i := 0; i < m; i++ {
+			entries[i][j] = coefAt(power, i)
+		}
+		power = target.MulPolynomials(power, embedding.Image())
+	}
+
+	forward, _ := NewMatrixFromInts(source.simple, entries)
+	backward, err := forward.Inverse()
+	if err != nil {
+		tryLog(source.enableLogging, err)
+		return Isomorphism{}, err
+	}
+	return Isomorphism{source, target, forward, backward}, nil
+}
+
+func (iso Isomorphism) Source() ExtendedField {
+	return iso.source
+}
+
+func (iso Isomorphism) Target() ExtendedField {
+	return iso.target
+}
+
+// Apply переводит элемент source в соответствующий элемент target.
+func (iso Isomorphism) Apply(a Polynomial) Polynomial {
+	return applyMatrix(iso.forward, iso.source.Normalize(a))
+}
+
+// ApplyInverse переводит элемент target обратно в source.
+func (iso Isomorphism) ApplyInverse(a Polynomial) Polynomial {
+	return applyMatrix(iso.backward, iso.target.Normalize(a))
+}
+
+// Inverse возвращает обратный изоморфизм target -> source.
+func (iso Isomorphism) Inverse() Isomorphism {
+	return Isomorphism{iso.target, iso.source, iso.backward, iso.forward}
+}
+
+// applyMatrix умножает матрицу над GF(p) на столбец коэффициентов многочлена.
+func applyMatrix(matrix Matrix, a Polynomial) Polynomial {
+	v := make([]Element, matrix.Cols())
+	for j := range v {
+		v[j] = NewElementFromInt(matrix.Field(), coefAt(a, j))
+	}
+	image, _ := matrix.MulVector(v)
+
+	coefs := make([]int, len(image))
+	for i, e := range image {
+		coefs[i] = coefAt(e.value, 0)
+	}
+	return newPolynomialNoReverse(coefs)
+}
@@ -0,0 +1,88 @@
+package polygfgo
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestNewIsomorphism(t *testing.T) {
+	aes := newAESField()
+	other := ExtendedField{SimpleField{2, false}, 2, 8, NewPolynomial([]int{1, 0, 0, 0, 1, 1, 1, 0, 1}), false}
+
+	iso, err := NewIsomorphism(aes, other)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	t.Run("isomorphism preserves arithmetic", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(1))
+		for i := 0; i < 100; i++ {
+			a, b := elementFromIndex(aes, rng.Intn(256)).value, elementFromIndex(aes, rng.Intn(256)).value
+
+			if got, want := iso.Apply(aes.MulPolynomials(a, b)), other.MulPolynomials(iso.Apply(a), iso.Apply(b)); !got.Equals(want) {
+				t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+			}
+			if got, want := iso.Apply(aes.AddPolynomials(a, b)), other.AddPolynomials(iso.Apply(a), iso.Apply(b)); !got.Equals(want) {
+				t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+			}
+		}
+	})
+
+	t.Run("inverse map round trip", func(t *testing.T) {
+		inverse := iso.Inverse()
+		for i := 0; i < 256; i++ {
+			a := elementFromIndex(aes, i).value
+
+			if got := inverse.Apply(iso.Apply(a)); !got.Equals(a) {
+				t.Errorf("Expected %s but got %s", a.ToString(), got.ToString())
+			}
+			if got := iso.ApplyInverse(iso.Apply(a)); !got.Equals(a) {
+				t.Errorf("Expected %s but got %s", a.ToString(), got.ToString())
+			}
+		}
+	})
+
+	t.Run("generator of source maps to a root of its generator", func(t *testing.T) {
+		root := iso.Apply(NewPolynomial([]int{1, 0}))
+		value := newZeroPolynomial()
+		for i := aes.generator.deg; i >= 0; i-- {
+			value = other.AddPolynomials(other.MulPolynomials(value, root), newPolynomialNoReverse([]int{aes.generator.coefs[i]}))
+		}
+
+		if !value.isZeroPolynomial() {
+			t.Errorf("Expected zero but got %s", value.ToString())
+		}
+	})
+
+	t.Run("fields of different size", func(t *testing.T) {
+		small := ExtendedField{SimpleField{2, false}, 2, 4, NewPolynomial([]int{1, 0, 0, 1, 1}), false}
+
+		if _, err := NewIsomorphism(aes, small); err == nil {
+			t.Errorf("Expected error for GF(2^8) and GF(2^4)")
+		}
+	})
+}
+
+func TestNewIsomorphism_OddCharacteristic(t *testing.T) {
+	source, err := StrictFieldFactory(5, 3, NewPolynomial([]int{1, 0, 3, 3}), false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	target, err := NewField(5, 3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	iso, err := NewIsomorphism(source.(ExtendedField), target.(ExtendedField))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for i := 0; i < 125; i++ {
+		a := elementFromIndex(source, i).value
+		b := elementFromIndex(source, (7*i+3)%125).value
+
+		if got, want := iso.Apply(source.MulPolynomials(a, b)), target.MulPolynomials(iso.Apply(a), iso.Apply(b)); !got.Equals(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+	}
+}
@@ -0,0 +1,463 @@
+package polygfgo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Matrix - матрица rows x cols над полем field. Элементы хранятся по строкам.
+type Matrix struct {
+	field      FieldInterface
+	rows, cols int
+	entries    [][]Element
+}
+
+// NewMatrix создаёт матрицу из строк entries. Строки должны иметь одинаковую длину,
+// а все элементы - принадлежать полю field.
+func NewMatrix(field FieldInterface, entries [][]Element) (Matrix, error) {
+	rows, cols := len(entries), 0
+	if rows > 0 {
+		cols = len(entries[0])
+	}
+
+	result := make([][]Element, rows)
+	for i, row := range entries {
+		if len(row) != cols {
+			return Matrix{}, fmt.Errorf("row %d has %d entries but row 0 has %d", i, len(row), cols)
+		}
+		for _, e := range row {
+			if !sameField(field, e.field) {
+				return Matrix{}, fmt.Errorf("entry %s does not belong to %s", e.ToString(), field.ToString())
+			}
+		}
+		result[i] = make([]Element, cols)
+		copy(result[i], row)
+	}
+	return Matrix{field, rows, cols, result}, nil
+}
+
+// NewMatrixFromInts создаёт матрицу, элементы которой - целые числа, приведённые в поле field.
+// Элементы заведомо принадлежат field, поэтому проверяется только длина строк.
+func NewMatrixFromInts(field FieldInterface, entries [][]int) (Matrix, error) {
+	rows, cols := len(entries), 0
+	if rows > 0 {
+		cols = len(entries[0])
+	}
+
+	result := make([][]Element, rows)
+	for i, row := range entries {
+		if len(row) != cols {
+			return Matrix{}, fmt.Errorf("row %d has %d entries but row 0 has %d", i, len(row), cols)
+		}
+		result[i] = make([]Element, cols)
+		for j, n := range row {
+			result[i][j] = NewElementFromInt(field, n)
+		}
+	}
+	return Matrix{field, rows, cols, result}, nil
+}
+
+// ZeroMatrix возвращает нулевую матрицу rows x cols.
+func ZeroMatrix(field FieldInterface, rows, cols int) Matrix {
+	entries := make([][]Element, rows)
+	for i := range entries {
+		entries[i] = make([]Element, cols)
+		for j := range entries[i] {
+			entries[i][j] = ZeroElement(field)
+		}
+	}
+	return Matrix{field, rows, cols, entries}
+}
+
+// IdentityMatrix возвращает единичную матрицу n x n.
+func IdentityMatrix(field FieldInterface, n int) Matrix {
+	m := ZeroMatrix(field, n, n)
+	for i := 0; i < n; i++ {
+		m.entries[i][i] = OneElement(field)
+	}
+	return m
+}
+
+func (m Matrix) Field() FieldInterface {
+	return m.field
+}
+
+func (m Matrix) Rows() int {
+	return m.rows
+}
+
+func (m Matrix) Cols() int {
+	return m.cols
+}
+
+func (m Matrix) At(i, j int) Element {
+	return m.entries[i][j]
+}
+
+// Row возвращает копию i-й строки.
+func (m Matrix) Row(i int) []Element {
+	row := make([]Element, m.cols)
+	copy(row, m.entries[i])
+	return row
+}
+
+func (m Matrix) Equal(other Matrix) bool {
+	if !sameField(m.field, other.field) || m.rows != other.rows || m.cols != other.cols {
+		return false
+	}
+	for i, row := range m.entries {
+		for j, e := range row {
+			if !e.Equal(other.entries[i][j]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (m Matrix) Add(other Matrix) (Matrix, error) {
+	if err := m.checkSameShape(other); err != nil {
+		return Matrix{}, err
+	}
+	result := m.copy()
+	for i, row := range result.entries {
+		for j := range row {
+			row[j] = row[j].Add(other.entries[i][j])
+		}
+	}
+	return result, nil
+}
+
+func (m Matrix) Sub(other Matrix) (Matrix, error) {
+	if err := m.checkSameShape(other); err != nil {
+		return Matrix{}, err
+	}
+	result := m.copy()
+	for i, row := range result.entries {
+		for j := range row {
+			row[j] = row[j].Sub(other.entries[i][j])
+		}
+	}
+	return result, nil
+}
+
+// Mul возвращает произведение m * other; число столбцов m должно совпадать с числом строк other.
+func (m Matrix) Mul(other Matrix) (Matrix, error) {
+	if !sameField(m.field, other.field) {
+		return Matrix{}, fmt.Errorf("matrices over %s and %s cannot be multiplied", m.field.ToString(), other.field.ToString())
+	}
+	if m.cols != other.rows {
+		return Matrix{}, fmt.Errorf("cannot multiply %dx%d matrix by %dx%d matrix", m.rows, m.cols, other.rows, other.cols)
+	}
+
+	result := ZeroMatrix(m.field, m.rows, other.cols)
+	for i, row := range m.entries {
+		for k, a := range row {
+			if a.IsZero() {
+				continue
+			}
+			for j, b := range other.entries[k] {
+				result.entries[i][j] = result.entries[i][j].Add(a.Mul(b))
+			}
+		}
+	}
+	return result, nil
+}
+
+// MulVector умножает матрицу на столбец v.
+func (m Matrix) MulVector(v []Element) ([]Element, error) {
+	column, err := NewMatrix(m.field, columnEntries(v))
+	if err != nil {
+		return nil, err
+	}
+	product, err := m.Mul(column)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Element, product.rows)
+	for i, row := range product.entries {
+		result[i] = row[0]
+	}
+	return result, nil
+}
+
+// Scale умножает все элементы матрицы на alpha.
+func (m Matrix) Scale(alpha Element) Matrix {
+	result := m.copy()
+	for _, row := range result.entries {
+		for j := range row {
+			row[j] = row[j].Mul(alpha)
+		}
+	}
+	return result
+}
+
+func (m Matrix) Transpose() Matrix {
+	result := ZeroMatrix(m.field, m.cols, m.rows)
+	for i, row := range m.entries {
+		for j, e := range row {
+			result.entries[j][i] = e
+		}
+	}
+	return result
+}
+
+// RowEchelonForm приводит матрицу методом Гаусса-Жордана к приведённому ступенчатому виду:
+// ведущие элементы равны единице и являются единственными ненулевыми в своих столбцах.
+// Вторым значением возвращаются номера ведущих столбцов по возрастанию.
+func (m Matrix) RowEchelonForm() (Matrix, []int) {
+	reduced, pivots, _ := m.eliminate()
+	return reduced, pivots
+}
+
+func (m Matrix) Rank() int {
+	_, pivots, _ := m.eliminate()
+	return len(pivots)
+}
+
+// Determinant вычисляет определитель квадратной матрицы приведением к ступенчатому виду.
+func (m Matrix) Determinant() (Element, error) {
+	if m.rows != m.cols {
+		return Element{}, fmt.Errorf("determinant of %dx%d matrix is not defined", m.rows, m.cols)
+	}
+	_, pivots, det := m.eliminate()
+	if len(pivots) < m.rows {
+		return ZeroElement(m.field), nil
+	}
+	return det, nil
+}
+
+// Inverse обращает квадратную матрицу, приводя [m | I] к виду [I | m^(-1)].
+func (m Matrix) Inverse() (Matrix, error) {
+	if m.rows != m.cols {
+		return Matrix{}, fmt.Errorf("%dx%d matrix is not invertible", m.rows, m.cols)
+	}
+	if m.rows == 0 {
+		return m, nil
+	}
+
+	augmented := m.appendColumns(IdentityMatrix(m.field, m.rows))
+	reduced, pivots, _ := augmented.eliminate()
+	if len(pivots) < m.rows || pivots[m.rows-1] >= m.cols {
+		return Matrix{}, fmt.Errorf("matrix is singular over %s", m.field.ToString())
+	}
+
+	result := ZeroMatrix(m.field, m.rows, m.cols)
+	for i := range result.entries {
+		copy(result.entries[i], reduced.entries[i][m.cols:])
+	}
+	return result, nil
+}
+
+// NullSpace возвращает базис пространства столбцов v с m * v = 0: по одному вектору
+// на каждый свободный столбец ступенчатого вида, в котором эта координата равна единице.
+func (m Matrix) NullSpace() [][]Element {
+	reduced, pivots, _ := m.eliminate()
+	isPivot := make([]bool, m.cols)
+	for _, col := range pivots {
+		isPivot[col] = true
+	}
+
+	basis := [][]Element{}
+	for free := 0; free < m.cols; free++ {
+		if isPivot[free] {
+			continue
+		}
+		v := make([]Element, m.cols)
+		for j := range v {
+			v[j] = ZeroElement(m.field)
+		}
+		v[free] = OneElement(m.field)
+		for i, col := range pivots {
+			v[col] = reduced.entries[i][free].Neg()
+		}
+		basis = append(basis, v)
+	}
+	return basis
+}
+
+// Solve находит решение системы m * x = b; свободные неизвестные полагаются равными нулю.
+// Если система несовместна, возвращается ошибка.
+func (m Matrix) Solve(b []Element) ([]Element, error) {
+	column, err := NewMatrix(m.field, columnEntries(b))
+	if err != nil {
+		return nil, err
+	}
+	if column.rows != m.rows {
+		return nil, fmt.Errorf("right-hand side has %d entries but matrix has %d rows", column.rows, m.rows)
+	}
+
+	reduced, pivots, _ := m.appendColumns(column).eliminate()
+	if len(pivots) > 0 && pivots[len(pivots)-1] == m.cols {
+		return nil, fmt.Errorf("linear system has no solution over %s", m.field.ToString())
+	}
+
+	x := make([]Element, m.cols)
+	for j := range x {
+		x[j] = ZeroElement(m.field)
+	}
+	for i, col := range pivots {
+		x[col] = reduced.entries[i][m.cols]
+	}
+	return x, nil
+}
+
+func (m Matrix) ToString() string {
+	rows := make([]string, m.rows)
+	for i, row := range m.entries {
+		parts := make([]string, len(row))
+		for j, e := range row {
+			parts[j] = e.value.ToString()
+		}
+		rows[i] = "[" + strings.Join(parts, " ") + "]"
+	}
+	return "[" + strings.Join(rows, " ") + "]"
+}
+
+// eliminate приводит копию матрицы к приведённому ступенчатому виду. Кроме ведущих столбцов
+// возвращает произведение ведущих элементов с учётом перестановок строк - определитель,
+// если матрица квадратная и невырожденная.
+func (m Matrix) eliminate() (Matrix, []int, Element) {
+	if m.field.GetDegree() == 1 {
+		return m.eliminatePrime()
+	}
+
+	a := m.copy()
+	pivots := []int{}
+	det := OneElement(m.field)
+
+	row := 0
+	for col := 0; col < a.cols && row < a.rows; col++ {
+		pivot := -1
+		for i := row; i < a.rows; i++ {
+			if !a.entries[i][col].IsZero() {
+				pivot = i
+				break
+			}
+		}
+		if pivot == -1 {
+			continue
+		}
+		if pivot != row {
+			a.entries[row], a.entries[pivot] = a.entries[pivot], a.entries[row]
+			det = det.Neg()
+		}
+
+		lead := a.entries[row][col]
+		det = det.Mul(lead)
+		inv, _ := lead.Inv()
+		for j := col; j < a.cols; j++ {
+			a.entries[row][j] = a.entries[row][j].Mul(inv)
+		}
+		for i := 0; i < a.rows; i++ {
+			factor := a.entries[i][col]
+			if i == row || factor.IsZero() {
+				continue
+			}
+			for j := col; j < a.cols; j++ {
+				a.entries[i][j] = a.entries[i][j].Sub(factor.Mul(a.entries[row][j]))
+			}
+		}
+		pivots = append(pivots, col)
+		row++
+	}
+	return a, pivots, det
+}
+
+// eliminatePrime выполняет то же приведение над GF(p) на числах: операции с Element
+// сравнивают поля при каждом действии, что заметно замедляет большие матрицы.
+func (m Matrix) eliminatePrime() (Matrix, []int, Element) {
+	f, p := m.field, m.field.GetPrime()
+	pu := uint64(p)
+	a := make([][]uint64, m.rows)
+	for i, row := range m.entries {
+		a[i] = make([]uint64, m.cols)
+		for j, e := range row {
+			a[i][j] = uint64(coefAt(e.value, 0))
+		}
+	}
+
+	pivots := []int{}
+	det := uint64(1) % pu
+	row := 0
+	for col := 0; col < m.cols && row < m.rows; col++ {
+		pivot := -1
+		for i := row; i < m.rows; i++ {
+			if a[i][col] != 0 {
+				pivot = i
+				break
+			}
+		}
+		if pivot == -1 {
+			continue
+		}
+		if pivot != row {
+			a[row], a[pivot] = a[pivot], a[row]
+			det = subMod(0, det, pu)
+		}
+
+		det = mulMod(det, a[row][col], pu)
+		inv := uint64(modInverse(int(a[row][col]), p))
+		for j := col; j < m.cols; j++ {
+			a[row][j] = mulMod(a[row][j], inv, pu)
+		}
+		for i := 0; i < m.rows; i++ {
+			factor := a[i][col]
+			if i == row || factor == 0 {
+				continue
+			}
+			for j := col; j < m.cols; j++ {
+				a[i][j] = subMod(a[i][j], mulMod(factor, a[row][j], pu), pu)
+			}
+		}
+		pivots = append(pivots, col)
+		row++
+	}
+
+	entries := make([][]Element, m.rows)
+	for i := range entries {
+		entries[i] = make([]Element, m.cols)
+		for j, v := range a[i] {
+			// Значения уже приведены по модулю p
+			entries[i][j] = Element{f, newPolynomialNoReverse([]int{int(v)})}
+		}
+	}
+	return Matrix{f, m.rows, m.cols, entries}, pivots, NewElementFromInt(f, int(det))
+}
+
+// appendColumns возвращает матрицу [m | other] с общим числом строк.
+func (m Matrix) appendColumns(other Matrix) Matrix {
+	entries := make([][]Element, m.rows)
+	for i := range entries {
+		entries[i] = append(m.Row(i), other.entries[i]...)
+	}
+	return Matrix{m.field, m.rows, m.cols + other.cols, entries}
+}
+
+func (m Matrix) copy() Matrix {
+	entries := make([][]Element, m.rows)
+	for i := range entries {
+		entries[i] = m.Row(i)
+	}
+	return Matrix{m.field, m.rows, m.cols, entries}
+}
+
+func (m Matrix) checkSameShape(other Matrix) error {
+	if !sameField(m.field, other.field) {
+		return fmt.Errorf("matrices over %s and %s are incompatible", m.field.ToString(), other.field.ToString())
+	}
+	if m.rows != other.rows || m.cols != other.cols {
+		return fmt.Errorf("matrices have different sizes %dx%d and %dx%d", m.rows, m.cols, other.rows, other.cols)
+	}
+	return nil
+}
+
+// columnEntries записывает вектор в виде строк матрицы-столбца.
+func columnEntries(v []Element) [][]Element {
+	entries := make([][]Element, len(v))
+	for i, e := range v {
+		entries[i] = []Element{e}
+	}
+	return entries
+}
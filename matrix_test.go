@@ -0,0 +1,327 @@
+package polygfgo
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// randomMatrix возвращает матрицу rows x cols со случайными элементами.
+func randomMatrix(field FieldInterface, rows, cols int, rng *rand.Rand) Matrix {
+	entries := make([][]Element, rows)
+	for i := range entries {
+		entries[i] = make([]Element, cols)
+		for j := range entries[i] {
+			entries[i][j] = randomElement(field, rng)
+		}
+	}
+	m, _ := NewMatrix(field, entries)
+	return m
+}
+
+func TestNewMatrix(t *testing.T) {
+	f := SimpleField{7, false}
+
+	t.Run("integers are reduced into the field", func(t *testing.T) {
+		m, err := NewMatrixFromInts(f, [][]int{{8, -1, 3}, {0, 14, 2}})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if m.Rows() != 2 || m.Cols() != 3 {
+			t.Fatalf("Expected 2x3 matrix but got %dx%d", m.Rows(), m.Cols())
+		}
+		if want := NewElementFromInt(f, 6); !m.At(0, 1).Equal(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), m.At(0, 1).ToString())
+		}
+	})
+
+	t.Run("ragged rows", func(t *testing.T) {
+		if _, err := NewMatrixFromInts(f, [][]int{{1, 2}, {3}}); err == nil {
+			t.Errorf("Expected error but got nil")
+		}
+	})
+
+	t.Run("entries from another field", func(t *testing.T) {
+		if _, err := NewMatrix(f, [][]Element{{NewElementFromInt(SimpleField{5, false}, 1)}}); err == nil {
+			t.Errorf("Expected error but got nil")
+		}
+	})
+}
+
+func TestMatrix_Arithmetic(t *testing.T) {
+	f := SimpleField{7, false}
+	a, _ := NewMatrixFromInts(f, [][]int{{1, 2, 3}, {4, 5, 6}})
+	b, _ := NewMatrixFromInts(f, [][]int{{1, 0}, {2, 1}, {0, 3}})
+
+	t.Run("multiplication", func(t *testing.T) {
+		got, err := a.Mul(b)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want, _ := NewMatrixFromInts(f, [][]int{{5, 11}, {14, 23}})
+		if !got.Equal(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+	})
+
+	t.Run("addition, subtraction and transpose", func(t *testing.T) {
+		sum, err := a.Add(b.Transpose())
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		back, _ := sum.Sub(b.Transpose())
+		if !back.Equal(a) {
+			t.Errorf("Expected %s but got %s", a.ToString(), back.ToString())
+		}
+
+		want, _ := NewMatrixFromInts(f, [][]int{{2, 4, 3}, {4, 6, 9}})
+		if !sum.Equal(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), sum.ToString())
+		}
+	})
+
+	t.Run("vector product", func(t *testing.T) {
+		got, err := a.MulVector([]Element{OneElement(f), OneElement(f), OneElement(f)})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !got[0].Equal(NewElementFromInt(f, 6)) || !got[1].Equal(NewElementFromInt(f, 1)) {
+			t.Errorf("Expected [6 1] but got [%s %s]", got[0].ToString(), got[1].ToString())
+		}
+	})
+
+	t.Run("incompatible sizes", func(t *testing.T) {
+		if _, err := a.Mul(a); err == nil {
+			t.Errorf("Expected error but got nil")
+		}
+		if _, err := a.Add(b); err == nil {
+			t.Errorf("Expected error but got nil")
+		}
+	})
+}
+
+func TestMatrix_RowEchelonForm(t *testing.T) {
+	f := SimpleField{5, false}
+	m, _ := NewMatrixFromInts(f, [][]int{
+		{0, 2, 4, 1},
+		{1, 1, 1, 1},
+		{1, 3, 0, 2},
+	})
+
+	reduced, pivots := m.RowEchelonForm()
+	want, _ := NewMatrixFromInts(f, [][]int{
+		{1, 0, 4, 3},
+		{0, 1, 2, 3},
+		{0, 0, 0, 0},
+	})
+	if !reduced.Equal(want) {
+		t.Errorf("Expected %s but got %s", want.ToString(), reduced.ToString())
+	}
+	if len(pivots) != 2 || pivots[0] != 0 || pivots[1] != 1 {
+		t.Errorf("Expected pivots [0 1] but got %v", pivots)
+	}
+	if got := m.Rank(); got != 2 {
+		t.Errorf("Expected rank %d but got %d", 2, got)
+	}
+
+	t.Run("every prime field is reduced on integers", func(t *testing.T) {
+		word, _ := NewWordField(5, MontgomeryReduction, false)
+		w, _ := NewMatrixFromInts(word, [][]int{
+			{0, 2, 4, 1},
+			{1, 1, 1, 1},
+			{1, 3, 0, 2},
+		})
+
+		got, _ := w.RowEchelonForm()
+		if _, ok := got.Field().(WordField); !ok || !got.Equal(want) {
+			t.Errorf("Expected %s over %s but got %s over %s", want.ToString(), word.ToString(), got.ToString(), got.Field().ToString())
+		}
+	})
+
+	t.Run("reduced form over GF(3^2)", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(5))
+		field, _ := NewField(3, 2)
+		a := randomMatrix(field, 4, 6, rng)
+
+		reduced, pivots := a.RowEchelonForm()
+		for i, col := range pivots {
+			for j := 0; j < reduced.Rows(); j++ {
+				if e := reduced.At(j, col); (i == j) != e.IsOne() || (i != j && !e.IsZero()) {
+					t.Fatalf("Expected unit pivot column %d but got %s", col, reduced.ToString())
+				}
+			}
+		}
+		for _, v := range a.NullSpace() {
+			product, _ := a.MulVector(v)
+			for _, e := range product {
+				if !e.IsZero() {
+					t.Errorf("Expected null space vector but got product %s", e.ToString())
+				}
+			}
+		}
+	})
+}
+
+func TestMatrix_Determinant(t *testing.T) {
+	f := SimpleField{7, false}
+
+	t.Run("known values", func(t *testing.T) {
+		cases := []struct {
+			entries [][]int
+			want    int
+		}{
+			{[][]int{{1, 2}, {3, 4}}, 5},
+			{[][]int{{0, 1}, {1, 0}}, 6},
+			{[][]int{{2, 0, 0}, {1, 3, 0}, {5, 6, 4}}, 3},
+			{[][]int{{1, 2}, {2, 4}}, 0},
+		}
+		for _, c := range cases {
+			m, _ := NewMatrixFromInts(f, c.entries)
+			got, err := m.Determinant()
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if want := NewElementFromInt(f, c.want); !got.Equal(want) {
+				t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+			}
+		}
+	})
+
+	t.Run("determinant is multiplicative in GF(2^8)", func(t *testing.T) {
+		field := newAESField()
+		rng := rand.New(rand.NewSource(1))
+		a, b := randomMatrix(field, 5, 5, rng), randomMatrix(field, 5, 5, rng)
+		product, _ := a.Mul(b)
+
+		detA, _ := a.Determinant()
+		detB, _ := b.Determinant()
+		got, _ := product.Determinant()
+		if want := detA.Mul(detB); !got.Equal(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+	})
+
+	t.Run("non-square matrix", func(t *testing.T) {
+		m, _ := NewMatrixFromInts(f, [][]int{{1, 2, 3}})
+		if _, err := m.Determinant(); err == nil {
+			t.Errorf("Expected error but got nil")
+		}
+	})
+}
+
+func TestMatrix_Inverse(t *testing.T) {
+	t.Run("inverse over a tower field", func(t *testing.T) {
+		_, field := newCompositeAESField(t)
+		rng := rand.New(rand.NewSource(2))
+		m := randomMatrix(field, 4, 4, rng)
+		if det, _ := m.Determinant(); det.IsZero() {
+			t.Fatalf("Expected a non-singular matrix")
+		}
+
+		inverse, err := m.Inverse()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		got, _ := m.Mul(inverse)
+		if want := IdentityMatrix(field, 4); !got.Equal(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+	})
+
+	t.Run("empty matrix", func(t *testing.T) {
+		f := SimpleField{3, false}
+		m, err := NewMatrix(f, [][]Element{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		inverse, err := m.Inverse()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if inverse.Rows() != 0 || inverse.Cols() != 0 {
+			t.Errorf("Expected 0x0 matrix but got %dx%d", inverse.Rows(), inverse.Cols())
+		}
+	})
+
+	t.Run("singular matrix", func(t *testing.T) {
+		m, _ := NewMatrixFromInts(SimpleField{3, false}, [][]int{{1, 2}, {2, 1}})
+		if _, err := m.Inverse(); err == nil {
+			t.Errorf("Expected error but got nil")
+		}
+	})
+}
+
+func TestMatrix_NullSpace(t *testing.T) {
+	field, err := NewField(3, 4)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	rng := rand.New(rand.NewSource(3))
+
+	// Ранг не больше 3, так как последняя строка - сумма первых двух
+	m := randomMatrix(field, 4, 7, rng)
+	m.entries[3] = m.Row(0)
+	for j := range m.entries[3] {
+		m.entries[3][j] = m.entries[0][j].Add(m.entries[1][j])
+	}
+
+	basis := m.NullSpace()
+	if want := m.Cols() - m.Rank(); len(basis) != want {
+		t.Fatalf("Expected %d basis vectors but got %d", want, len(basis))
+	}
+	for _, v := range basis {
+		image, _ := m.MulVector(v)
+		for _, e := range image {
+			if !e.IsZero() {
+				t.Errorf("Expected zero vector but got %s", e.ToString())
+			}
+		}
+	}
+}
+
+func TestMatrix_Solve(t *testing.T) {
+	f := SimpleField{1000000007, false}
+	rng := rand.New(rand.NewSource(4))
+
+	t.Run("consistent system", func(t *testing.T) {
+		m := randomMatrix(f, 6, 6, rng)
+		x := make([]Element, 6)
+		for i := range x {
+			x[i] = randomElement(f, rng)
+		}
+		b, _ := m.MulVector(x)
+
+		got, err := m.Solve(b)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		for i := range x {
+			if !got[i].Equal(x[i]) {
+				t.Errorf("Expected %s but got %s", x[i].ToString(), got[i].ToString())
+			}
+		}
+	})
+
+	t.Run("underdetermined system", func(t *testing.T) {
+		m := randomMatrix(f, 3, 5, rng)
+		b := []Element{OneElement(f), ZeroElement(f), NewElementFromInt(f, 2)}
+
+		x, err := m.Solve(b)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		got, _ := m.MulVector(x)
+		for i := range b {
+			if !got[i].Equal(b[i]) {
+				t.Errorf("Expected %s but got %s", b[i].ToString(), got[i].ToString())
+			}
+		}
+	})
+
+	t.Run("inconsistent system", func(t *testing.T) {
+		m, _ := NewMatrixFromInts(f, [][]int{{1, 1}, {2, 2}})
+		if _, err := m.Solve([]Element{OneElement(f), OneElement(f)}); err == nil {
+			t.Errorf("Expected error but got nil")
+		}
+	})
+}
@@ -0,0 +1,269 @@
+package polygfgo
+
+import (
+	"fmt"
+)
+
+// subproductTreeThreshold - число точек, начиная с которого вычисление значений и
+// интерполяция выполняются через дерево произведений, а не квадратичными методами.
+const subproductTreeThreshold = 64
+
+// EvalMany вычисляет значения многочлена во всех точках points.
+func (f SimpleField) EvalMany(poly Polynomial, points []int) []int {
+	values := make([]int, len(points))
+	if len(points) < subproductTreeThreshold {
+		for i, x := range points {
+			values[i] = f.Eval(poly, x)
+		}
+		return values
+	}
+
+	for i, r := range f.remainderTree(poly, f.subproductTree(points)) {
+		values[i] = coefAt(r, 0)
+	}
+	return values
+}
+
+// Interpolate находит многочлен степени меньше len(points), принимающий в точках points
+// значения values. Точки должны быть различны по модулю p.
+func (f SimpleField) Interpolate(points, values []int) (Polynomial, error) {
+	if err := f.checkInterpolationNodes(points, values); err != nil {
+		tryLog(f.enableLogging, err)
+		return newZeroPolynomial(), err
+	}
+	if len(points) < subproductTreeThreshold {
+		return f.newtonInterpolation(points, values), nil
+	}
+
+	// Lagrange: P = sum y_i / M'(x_i) * M / (x - x_i), где M - произведение всех (x - x_i)
+	tree := f.subproductTree(points)
+	root := tree[len(tree)-1][0]
+	denominators := f.remainderTree(f.Derivative(root), tree)
+
+	level := make([]Polynomial, len(points))
+	pu := uint64(f.p)
+	for i, d := range denominators {
+		weight := mulMod(uint64(reduceInt(values[i], f.p)), uint64(modInverse(coefAt(d, 0), f.p)), pu)
+		level[i] = newPolynomialNoReverse([]int{int(weight)})
+	}
+	for k := 0; k+1 < len(tree); k++ {
+		next := make([]Polynomial, len(tree[k+1]))
+		for i := range next {
+			if 2*i+1 == len(level) {
+				next[i] = level[2*i]
+				continue
+			}
+			next[i] = f.AddPolynomials(f.MulPolynomials(level[2*i], tree[k][2*i+1]), f.MulPolynomials(level[2*i+1], tree[k][2*i]))
+		}
+		level = next
+	}
+	return level[0], nil
+}
+
+// subproductTree строит дерево произведений многочленов x - points[i].
+func (f SimpleField) subproductTree(points []int) [][]Polynomial {
+	leaves := make([]Polynomial, len(points))
+	for i, x := range points {
+		leaves[i] = f.Normalize(newPolynomialNoReverse([]int{-x, 1}))
+	}
+	return f.productTree(leaves)
+}
+
+// productTree строит дерево произведений: нижний уровень - многочлены leaves,
+// каждый следующий - попарные произведения предыдущего; непарный узел переносится вверх.
+func (f SimpleField) productTree(leaves []Polynomial) [][]Polynomial {
+	level := leaves
+	tree := [][]Polynomial{level}
+	for len(level) > 1 {
+		next := make([]Polynomial, (len(level)+1)/2)
+		for i := range next {
+			if 2*i+1 == len(level) {
+				next[i] = level[2*i]
+			} else {
+				next[i] = f.MulPolynomials(level[2*i], level[2*i+1])
+			}
+		}
+		tree = append(tree, next)
+		level = next
+	}
+	return tree
+}
+
+// remainderTree спускает остатки от деления poly по дереву произведений и возвращает
+// остатки по модулю листьев; для листьев x - x_i это значения poly(x_i).
+func (f SimpleField) remainderTree(poly Polynomial, tree [][]Polynomial) []Polynomial {
+	_, r, _ := f.DivPolynomials(poly, tree[len(tree)-1][0])
+	remainders := []Polynomial{r}
+	for k := len(tree) - 2; k >= 0; k-- {
+		next := make([]Polynomial, len(tree[k]))
+		for i, node := range tree[k] {
+			_, next[i], _ = f.DivPolynomials(remainders[i/2], node)
+		}
+		remainders = next
+	}
+	return remainders
+}
+
+// newtonInterpolation строит интерполяционный многочлен через разделённые разности.
+func (f SimpleField) newtonInterpolation(points, values []int) Polynomial {
+	n := len(points)
+	pu := uint64(f.p)
+	xs := make([]uint64, n)
+	diffs := make([]uint64, n)
+	for i := range points {
+		xs[i] = uint64(reduceInt(points[i], f.p))
+		diffs[i] = uint64(reduceInt(values[i], f.p))
+	}
+	for k := 1; k < n; k++ {
+		for i := n - 1; i >= k; i-- {
+			denominator := uint64(modInverse(int(subMod(xs[i], xs[i-k], pu)), f.p))
+			diffs[i] = mulMod(subMod(diffs[i], diffs[i-1], pu), denominator, pu)
+		}
+	}
+
+	// Схема Горнера для формы Ньютона: P = d_0 + (x - x_0)(d_1 + (x - x_1)(...))
+	result := newZeroPolynomial()
+	for i := n - 1; i >= 0; i-- {
+		result = f.MulPolynomials(result, newPolynomialNoReverse([]int{-int(xs[i]), 1}))
+		result = f.AddPolynomials(result, newPolynomialNoReverse([]int{int(diffs[i])}))
+	}
+	return result
+}
+
+func (f SimpleField) checkInterpolationNodes(points, values []int) error {
+	if len(points) != len(values) {
+		return fmt.Errorf("got %d points but %d values", len(points), len(values))
+	}
+	seen := make(map[int]bool, len(points))
+	for _, x := range points {
+		x = reduceInt(x, f.p)
+		if seen[x] {
+			return fmt.Errorf("interpolation point %d is repeated in %s", x, f.ToString())
+		}
+		seen[x] = true
+	}
+	return nil
+}
+
+// EvalMany вычисляет значения многочлена над GF(p^m) во всех точках points.
+func (f ExtendedField) EvalMany(poly FieldPolynomial, points []Element) []Element {
+	values := make([]Element, len(points))
+	if len(points) < subproductTreeThreshold {
+		for i, x := range points {
+			values[i] = poly.Eval(x)
+		}
+		return values
+	}
+
+	for i, r := range fieldRemainderTree(poly, fieldSubproductTree(f, points)) {
+		values[i] = r.Coefficient(0)
+	}
+	return values
+}
+
+// Interpolate находит многочлен над GF(p^m) степени меньше len(points), принимающий
+// в различных точках points значения values.
+func (f ExtendedField) Interpolate(points, values []Element) (FieldPolynomial, error) {
+	if err := f.checkInterpolationNodes(points, values); err != nil {
+		tryLog(f.enableLogging, err)
+		return newZeroFieldPolynomial(f), err
+	}
+	if len(points) < subproductTreeThreshold {
+		return fieldNewtonInterpolation(f, points, values), nil
+	}
+
+	tree := fieldSubproductTree(f, points)
+	root := tree[len(tree)-1][0]
+	denominators := fieldRemainderTree(root.Derivative(), tree)
+
+	level := make([]FieldPolynomial, len(points))
+	for i, d := range denominators {
+		weight, _ := values[i].Div(d.Coefficient(0))
+		level[i] = newMonomialFieldPolynomial(weight, 0)
+	}
+	for k := 0; k+1 < len(tree); k++ {
+		next := make([]FieldPolynomial, len(tree[k+1]))
+		for i := range next {
+			if 2*i+1 == len(level) {
+				next[i] = level[2*i]
+				continue
+			}
+			next[i] = level[2*i].Mul(tree[k][2*i+1]).Add(level[2*i+1].Mul(tree[k][2*i]))
+		}
+		level = next
+	}
+	return level[0], nil
+}
+
+func (f ExtendedField) checkInterpolationNodes(points, values []Element) error {
+	if len(points) != len(values) {
+		return fmt.Errorf("got %d points but %d values", len(points), len(values))
+	}
+	seen := make(map[string]bool, len(points))
+	for i, x := range points {
+		if !sameField(f, x.field) || !sameField(f, values[i].field) {
+			return fmt.Errorf("interpolation data does not belong to %s", f.ToString())
+		}
+		if seen[x.value.ToString()] {
+			return fmt.Errorf("interpolation point %s is repeated", x.ToString())
+		}
+		seen[x.value.ToString()] = true
+	}
+	return nil
+}
+
+// fieldSubproductTree аналогична SimpleField.subproductTree для многочленов над полем field.
+func fieldSubproductTree(field FieldInterface, points []Element) [][]FieldPolynomial {
+	level := make([]FieldPolynomial, len(points))
+	for i, x := range points {
+		level[i] = newFieldPolynomialNoReverse(field, []Element{x.Neg(), OneElement(field)})
+	}
+
+	tree := [][]FieldPolynomial{level}
+	for len(level) > 1 {
+		next := make([]FieldPolynomial, (len(level)+1)/2)
+		for i := range next {
+			if 2*i+1 == len(level) {
+				next[i] = level[2*i]
+			} else {
+				next[i] = level[2*i].Mul(level[2*i+1])
+			}
+		}
+		tree = append(tree, next)
+		level = next
+	}
+	return tree
+}
+
+// fieldRemainderTree аналогична SimpleField.remainderTree для многочленов над полем.
+func fieldRemainderTree(poly FieldPolynomial, tree [][]FieldPolynomial) []FieldPolynomial {
+	_, r, _ := poly.DivMod(tree[len(tree)-1][0])
+	remainders := []FieldPolynomial{r}
+	for k := len(tree) - 2; k >= 0; k-- {
+		next := make([]FieldPolynomial, len(tree[k]))
+		for i, node := range tree[k] {
+			_, next[i], _ = remainders[i/2].DivMod(node)
+		}
+		remainders = next
+	}
+	return remainders
+}
+
+// fieldNewtonInterpolation аналогична SimpleField.newtonInterpolation для элементов поля.
+func fieldNewtonInterpolation(field FieldInterface, points, values []Element) FieldPolynomial {
+	n := len(points)
+	diffs := make([]Element, n)
+	copy(diffs, values)
+	for k := 1; k < n; k++ {
+		for i := n - 1; i >= k; i-- {
+			diffs[i], _ = diffs[i].Sub(diffs[i-1]).Div(points[i].Sub(points[i-k]))
+		}
+	}
+
+	result := newZeroFieldPolynomial(field)
+	for i := n - 1; i >= 0; i-- {
+		result = result.Mul(newFieldPolynomialNoReverse(field, []Element{points[i].Neg(), OneElement(field)}))
+		result = result.Add(newMonomialFieldPolynomial(diffs[i], 0))
+	}
+	return result
+}
@@ -0,0 +1,121 @@
+package polygfgo
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSimpleField_EvalMany(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, p := range []int{998244353, 2305843009213693951} {
+		f := SimpleField{p, false}
+		for _, n := range []int{10, 200} {
+			poly := f.randomPolynomial(rng, 300)
+			points := make([]int, n)
+			for i := range points {
+				points[i] = int(rng.Int63n(int64(p)))
+			}
+
+			got := f.EvalMany(poly, points)
+
+			for i, x := range points {
+				if want := f.Eval(poly, x); got[i] != want {
+					t.Errorf("Expected %d but got %d at %d over GF(%d)", want, got[i], x, p)
+				}
+			}
+		}
+	}
+}
+
+func TestSimpleField_Interpolate(t *testing.T) {
+	t.Run("parabola through three points over GF(7)", func(t *testing.T) {
+		f := SimpleField{7, false}
+
+		got, err := f.Interpolate([]int{0, 1, 2}, []int{1, 2, 5})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := NewPolynomial([]int{1, 0, 1})
+
+		if !got.Equals(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+	})
+
+	t.Run("round trip through evaluation", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(2))
+		for _, p := range []int{998244353, 2305843009213693951} {
+			f := SimpleField{p, false}
+			for _, n := range []int{20, 150} {
+				poly := f.randomPolynomial(rng, n)
+				points := make([]int, n)
+				for i := range points {
+					points[i] = i*1000003 + 17
+				}
+
+				got, err := f.Interpolate(points, f.EvalMany(poly, points))
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+
+				if !got.Equals(poly) {
+					t.Errorf("Expected %s but got %s", poly.ToString(), got.ToString())
+				}
+			}
+		}
+	})
+
+	t.Run("points repeated modulo p", func(t *testing.T) {
+		f := SimpleField{7, false}
+
+		if _, err := f.Interpolate([]int{1, 8}, []int{2, 3}); err == nil {
+			t.Errorf("Expected error for repeated points")
+		}
+	})
+
+	t.Run("length mismatch", func(t *testing.T) {
+		f := SimpleField{7, false}
+
+		if _, err := f.Interpolate([]int{1, 2}, []int{3}); err == nil {
+			t.Errorf("Expected error for length mismatch")
+		}
+	})
+}
+
+func TestExtendedField_EvalManyInterpolate(t *testing.T) {
+	f := newAESField()
+	rng := rand.New(rand.NewSource(3))
+
+	for _, n := range []int{8, 100} {
+		coefs := make([]Element, n)
+		points := make([]Element, n)
+		for i := range coefs {
+			coefs[i] = byteElement(f, rng.Intn(256))
+			points[i] = byteElement(f, 2*i+1)
+		}
+		poly := newFieldPolynomialNoReverse(f, coefs)
+
+		values := f.EvalMany(poly, points)
+		for i, x := range points {
+			if want := poly.Eval(x); !values[i].Equal(want) {
+				t.Errorf("Expected %s but got %s", want.ToString(), values[i].ToString())
+			}
+		}
+
+		got, err := f.Interpolate(points, values)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !got.Equals(poly) {
+			t.Errorf("Expected %s but got %s", poly.ToString(), got.ToString())
+		}
+	}
+
+	t.Run("repeated points", func(t *testing.T) {
+		points := []Element{byteElement(f, 0x01), byteElement(f, 0x01)}
+
+		if _, err := f.Interpolate(points, points); err == nil {
+			t.Errorf("Expected error for repeated points")
+		}
+	})
+}
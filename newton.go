@@ -0,0 +1,124 @@
+package polygfgo
+
+import (
+	"fmt"
+)
+
+// newtonDivisionThreshold - степень делителя и частного, начиная с которой деление
+// выполняется через обращение степенного ряда вместо деления столбиком.
+const newtonDivisionThreshold = 64
+
+// Modulus - делитель над GF(p) с заранее вычисленным обратным рядом к его развёрнутой
+// записи. Деление на него сводится к двум умножениям многочленов.
+type Modulus struct {
+	field   SimpleField
+	poly    Polynomial
+	inverse Polynomial // rev(poly)^(-1) mod x^(deg poly); только при deg poly >= newtonDivisionThreshold
+}
+
+// NewModulus подготавливает многочлен poly для многократного деления на него.
+// Обратный ряд вычисляется только для делителей, на которые делится методом Ньютона.
+func (f SimpleField) NewModulus(poly Polynomial) (Modulus, error) {
+	poly = f.Normalize(poly)
+	if poly.isZeroPolynomial() {
+		err := fmt.Errorf("division by zero is not supported")
+		tryLog(f.enableLogging, err)
+		return Modulus{}, err
+	}
+
+	if poly.deg < newtonDivisionThreshold {
+		return Modulus{f, poly, newZeroPolynomial()}, nil
+	}
+	inverse, err := f.InvSeries(reversePolynomial(poly, poly.deg+1), poly.deg)
+	if err != nil {
+		return Modulus{}, err
+	}
+	return Modulus{f, poly, inverse}, nil
+}
+
+func (m Modulus) Poly() Polynomial {
+	return m.poly
+}
+
+// DivMod делит poly на модуль: poly = quot*m + rem, deg rem < deg m.
+// При малых степенях используется деление столбиком, которое в этом случае быстрее.
+func (m Modulus) DivMod(poly Polynomial) (quot, rem Polynomial) {
+	f := m.field
+	poly = f.Normalize(poly)
+	if poly.deg < m.poly.deg {
+		return newZeroPolynomial(), poly
+	}
+	if m.poly.deg < newtonDivisionThreshold || poly.deg-m.poly.deg < newtonDivisionThreshold {
+		quot, rem, _ = f.longDivision(poly, m.poly)
+		return
+	}
+
+	inverse := m.inverse
+	if k := poly.deg - m.poly.deg + 1; k > m.poly.deg {
+		// Точности подготовленного ряда не хватает для такого длинного частного
+		inverse, _ = f.InvSeries(reversePolynomial(m.poly, m.poly.deg+1), k)
+	}
+	return f.divideByInverse(poly, m.poly, inverse)
+}
+
+// Reduce возвращает остаток от деления poly на модуль.
+func (m Modulus) Reduce(poly Polynomial) Polynomial {
+	_, rem := m.DivMod(poly)
+	return rem
+}
+
+// InvSeries возвращает многочлен g степени меньше n, для которого poly*g = 1 mod x^n.
+// Используется итерация Ньютона g = g*(2 - poly*g), удваивающая точность на каждом шаге.
+func (f SimpleField) InvSeries(poly Polynomial, n int) (Polynomial, error) {
+	poly = f.Normalize(poly)
+	if n < 1 || poly.isZeroPolynomial() || poly.coefs[0] == 0 {
+		err := fmt.Errorf("polynomial %s is not invertible modulo x^%d", poly.ToString(), n)
+		tryLog(f.enableLogging, err)
+		return newZeroPolynomial(), err
+	}
+
+	g := newPolynomialNoReverse([]int{modInverse(poly.coefs[0], f.p)})
+	two := newPolynomialNoReverse([]int{2})
+	for precision := 1; precision < n; {
+		precision = min(2*precision, n)
+		e := truncatePolynomial(f.MulPolynomials(truncatePolynomial(poly, precision), g), precision)
+		g = truncatePolynomial(f.MulPolynomials(g, f.SubPolynomials(two, e)), precision)
+	}
+	return g, nil
+}
+
+// newtonDivision делит p1 на p2, обращая развёрнутый делитель с точностью, равной длине частного.
+// Многочлены должны быть приведены по модулю p, deg p1 >= deg p2.
+func (f SimpleField) newtonDivision(p1, p2 Polynomial) (quot, rem Polynomial) {
+	inverse, _ := f.InvSeries(reversePolynomial(p2, p2.deg+1), p1.deg-p2.deg+1)
+	return f.divideByInverse(p1, p2, inverse)
+}
+
+// divideByInverse вычисляет rev(quot) = rev(poly) * rev(divisor)^(-1) mod x^k, где k - длина частного.
+// Ряд inverse должен иметь точность не меньше k.
+func (f SimpleField) divideByInverse(poly, divisor, inverse Polynomial) (quot, rem Polynomial) {
+	k := poly.deg - divisor.deg + 1
+	reversed := truncatePolynomial(reversePolynomial(poly, poly.deg+1), k)
+	revQuot := truncatePolynomial(f.MulPolynomials(reversed, truncatePolynomial(inverse, k)), k)
+
+	quot = reversePolynomial(revQuot, k)
+	rem = truncatePolynomial(f.SubPolynomials(poly, f.MulPolynomials(quot, divisor)), divisor.deg)
+	return
+}
+
+// reversePolynomial возвращает x^(n-1) * poly(1/x) - коэффициенты первых n позиций в обратном порядке.
+func reversePolynomial(poly Polynomial, n int) Polynomial {
+	coefs := make([]int, n)
+	for i := 0; i < n && i <= poly.deg; i++ {
+		coefs[n-1-i] = poly.coefs[i]
+	}
+	return newPolynomialNoReverse(coefs)
+}
+
+// truncatePolynomial возвращает poly mod x^n.
+func truncatePolynomial(poly Polynomial, n int) Polynomial {
+	if poly.deg < n {
+		return poly
+	}
+	return newPolynomialNoReverse(poly.coefs[:n])
+}
@@ -0,0 +1,155 @@
+package polygfgo
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSimpleField_InvSeries(t *testing.T) {
+	t.Run("inverse of 1 - x is the geometric series", func(t *testing.T) {
+		f := SimpleField{7, false}
+
+		got, err := f.InvSeries(NewPolynomial([]int{-1, 1}), 5)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := NewPolynomial([]int{1, 1, 1, 1, 1})
+
+		if !got.Equals(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+	})
+
+	t.Run("product with the inverse is 1 modulo x^n", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(1))
+		f := SimpleField{2305843009213693951, false}
+		poly := f.AddPolynomials(f.randomPolynomial(rng, 300), NewPolynomial([]int{1}))
+		if poly.coefs[0] == 0 {
+			poly = f.AddPolynomials(poly, NewPolynomial([]int{1}))
+		}
+
+		inverse, err := f.InvSeries(poly, 257)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		got := truncatePolynomial(f.MulPolynomials(poly, inverse), 257)
+
+		if !got.Equals(NewPolynomial([]int{1})) || inverse.deg >= 257 {
+			t.Errorf("Expected 1 but got %s", got.ToString())
+		}
+	})
+
+	t.Run("series without constant term", func(t *testing.T) {
+		f := SimpleField{7, false}
+
+		if _, err := f.InvSeries(NewPolynomial([]int{1, 0}), 3); err == nil {
+			t.Errorf("Expected error for x")
+		}
+	})
+}
+
+func TestSimpleField_NewtonDivision(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for _, p := range []int{2, 7, 1000000007, 2305843009213693951} {
+		f := SimpleField{p, false}
+		for _, sizes := range [][2]int{{400, 150}, {1000, 100}, {300, 290}, {700, 64}} {
+			p1 := f.randomPolynomial(rng, sizes[0])
+			p2 := f.AddPolynomials(f.randomPolynomial(rng, sizes[1]), newMonomialPolynomial(sizes[1]))
+
+			quot, rem, err := f.DivPolynomials(p1, p2)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			wantQuot, wantRem, _ := f.longDivision(p1, p2)
+
+			if !quot.Equals(wantQuot) || !rem.Equals(wantRem) {
+				t.Errorf("Division of degree %d by degree %d over GF(%d) differs from long division", p1.deg, p2.deg, p)
+			}
+		}
+	}
+}
+
+func TestModulus_Reduce(t *testing.T) {
+	t.Run("repeated reductions agree with long division", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(3))
+		f := SimpleField{998244353, false}
+		mod := f.AddPolynomials(f.randomPolynomial(rng, 100), newMonomialPolynomial(100))
+
+		modulus, err := f.NewModulus(mod)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		for _, n := range []int{50, 199, 260, 600} {
+			poly := f.randomPolynomial(rng, n)
+			_, want, _ := f.longDivision(poly, mod)
+
+			if got := modulus.Reduce(poly); !got.Equals(want) {
+				t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+			}
+		}
+	})
+
+	t.Run("zero modulus", func(t *testing.T) {
+		f := SimpleField{5, false}
+
+		if _, err := f.NewModulus(NewPolynomial([]int{5})); err == nil {
+			t.Errorf("Expected error for zero modulus")
+		}
+	})
+
+	t.Run("extension field of large degree is reduced by Newton division", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(4))
+		simple := SimpleField{3, false}
+		generator := randomIrreducible(rng, simple, 70)
+		f := ExtendedField{simple, 3, 70, generator, false}
+
+		a, b := simple.randomPolynomial(rng, 70), simple.randomPolynomial(rng, 70)
+		_, want, _ := simple.longDivision(simple.MulPolynomials(a, b), generator)
+
+		if got := f.MulPolynomials(a, b); !got.Equals(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+		inverse, err := f.InvPolynomial(a)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got := f.MulPolynomials(a, inverse); !got.Equals(NewPolynomial([]int{1})) {
+			t.Errorf("Expected 1 but got %s", got.ToString())
+		}
+	})
+}
+
+// newMonomialPolynomial возвращает x^n.
+func newMonomialPolynomial(n int) Polynomial {
+	coefs := make([]int, n+1)
+	coefs[n] = 1
+	return Polynomial{coefs, n + 1, n}
+}
+
+// randomIrreducible возвращает случайный нормированный неприводимый многочлен степени n.
+func randomIrreducible(rng *rand.Rand, f SimpleField, n int) Polynomial {
+	for {
+		poly := f.AddPolynomials(f.randomPolynomial(rng, n), newMonomialPolynomial(n))
+		if f.IsIrreducible(poly) {
+			return poly
+		}
+	}
+}
+
+func BenchmarkSimpleField_DivPolynomials(b *testing.B) {
+	rng := rand.New(rand.NewSource(5))
+	f := SimpleField{998244353, false}
+	p1 := f.randomPolynomial(rng, 4000)
+	p2 := f.AddPolynomials(f.randomPolynomial(rng, 2000), newMonomialPolynomial(2000))
+
+	b.Run("newton", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			f.DivPolynomials(p1, p2)
+		}
+	})
+	b.Run("long division", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			f.longDivision(p1, p2)
+		}
+	})
+}
@@ -0,0 +1,325 @@
+package polygfgo
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// Умножение многочленов без потери точности.
+// Коэффициенты рассматриваются как вычеты по модулю mod; mod = 0 означает
+// арифметику по модулю 2^64 (обычное переполнение uint64), которая точна для
+// умножения над целыми числами, пока результат помещается в int.
+// В зависимости от размера используется умножение "в столбик", Карацуба
+// или теоретико-числовое преобразование (NTT) по нескольким простым с восстановлением по КТО.
+
+const (
+	schoolbookThreshold = 32
+	karatsubaThreshold  = 512
+)
+
+// nttPrime - простое вида c*2^k + 1 (меньше 2^62) и его первообразный корень.
+type nttPrime struct {
+	p uint64
+	g uint64
+}
+
+// Произведение трёх модулей больше 2^185, чего хватает для любых входных
+// коэффициентов int. Наименьшая степень двойки, делящая p - 1, равна 2^41,
+// поэтому длина преобразования ограничена maxNTTLength.
+const maxNTTLength = 1 << 41
+
+var nttPrimes = []nttPrime{
+	{4611615649683210241, 11},
+	{4611613450659954689, 3},
+	{4611549678985543681, 19},
+}
+
+// mulCoefs возвращает произведение многочленов a и b (коэффициенты от младшего к старшему)
+// по модулю mod. Входные коэффициенты должны лежать в [0, mod), при mod = 0 - любые.
+func mulCoefs(a, b []uint64, mod uint64) []uint64 {
+	if len(a) == 0 || len(b) == 0 {
+		return []uint64{}
+	}
+	if min(len(a), len(b)) <= schoolbookThreshold {
+		return schoolbookMul(a, b, mod)
+	}
+	if max(len(a), len(b)) <= karatsubaThreshold {
+		return karatsubaMul(a, b, mod)
+	}
+	return nttMul(a, b, mod)
+}
+
+func addMod(a, b, mod uint64) uint64 {
+	if mod == 0 {
+		return a + b
+	}
+	s := a + b
+	if s >= mod {
+		s -= mod
+	}
+	return s
+}
+
+func subMod(a, b, mod uint64) uint64 {
+	if mod == 0 {
+		return a - b
+	}
+	if a >= b {
+		return a - b
+	}
+	return a + mod - b
+}
+
+func mulModRing(a, b, mod uint64) uint64 {
+	if mod == 0 {
+		return a * b
+	}
+	return mulMod(a, b, mod)
+}
+
+func schoolbookMul(a, b []uint64, mod uint64) []uint64 {
+	result := make([]uint64, len(a)+len(b)-1)
+	if mod == 0 {
+		for i, x := range a {
+			for j, y := range b {
+				result[i+j] += x * y
+			}
+		}
+		return result
+	}
+
+	// Накапливаем 192-битные суммы и приводим по модулю один раз на коэффициент
+	top := make([]uint64, len(result))
+	hi := make([]uint64, len(result))
+	for i, x := range a {
+		for j, y := range b {
+			h, l := bits.Mul64(x, y)
+			var carry uint64
+			result[i+j], carry = bits.Add64(result[i+j], l, 0)
+			hi[i+j], carry = bits.Add64(hi[i+j], h, carry)
+			top[i+j] += carry
+		}
+	}
+	for k := range result {
+		r := bits.Rem64(top[k]%mod, hi[k], mod)
+		result[k] = bits.Rem64(r, result[k], mod)
+	}
+	return result
+}
+
+func karatsubaMul(a, b []uint64, mod uint64) []uint64 {
+	n := max(len(a), len(b))
+	result := karatsuba(expandUint64(a, n), expandUint64(b, n), mod)
+	return result[:len(a)+len(b)-1]
+}
+
+// karatsuba умножает многочлены одинаковой длины n, результат имеет длину 2n-1.
+func karatsuba(a, b []uint64, mod uint64) []uint64 {
+	n := len(a)
+	if n <= schoolbookThreshold {
+		return schoolbookMul(a, b, mod)
+	}
+
+	half := n / 2
+	a0, a1 := a[:half], a[half:]
+	b0, b1 := b[:half], b[half:]
+
+	// a1, b1 имеют длину n-half >= half, дополняем младшие половины до неё
+	h := n - half
+	sa := make([]uint64, h)
+	sb := make([]uint64, h)
+	for i := 0; i < h; i++ {
+		sa[i] = a1[i]
+		sb[i] = b1[i]
+		if i < half {
+			sa[i] = addMod(sa[i], a0[i], mod)
+			sb[i] = addMod(sb[i], b0[i], mod)
+		}
+	}
+
+	z0 := karatsuba(a0, b0, mod)
+	z2 := karatsuba(a1, b1, mod)
+	z1 := karatsuba(sa, sb, mod)
+	for i := range z0 {
+		z1[i] = subMod(z1[i], z0[i], mod)
+	}
+	for i := range z2 {
+		z1[i] = subMod(z1[i], z2[i], mod)
+	}
+
+	result := make([]uint64, 2*n-1)
+	for i, v := range z0 {
+		result[i] = v
+	}
+	for i, v := range z2 {
+		result[i+2*half] = addMod(result[i+2*half], v, mod)
+	}
+	for i, v := range z1 {
+		result[i+half] = addMod(result[i+half], v, mod)
+	}
+	return result
+}
+
+// nttMul умножает через NTT по нескольким простым и восстанавливает результат по КТО (алгоритм Гарнера).
+func nttMul(a, b []uint64, mod uint64) []uint64 {
+	resultLen := len(a) + len(b) - 1
+	primes := nttPrimes
+	if mod != 0 {
+		// Коэффициенты произведения не превосходят n*(mod-1)^2
+		boundBits := 2*bits.Len64(mod-1) + bits.Len64(uint64(min(len(a), len(b))))
+		primes = nttPrimes[:min(len(nttPrimes), boundBits/61+1)]
+	}
+
+	invs := garnerInverses(primes)
+	residues := make([][]uint64, len(primes))
+	for i, prime := range primes {
+		residues[i] = nttConvolve(reduceUint64(a, prime.p, mod == 0), reduceUint64(b, prime.p, mod == 0), prime)[:resultLen]
+	}
+
+	result := make([]uint64, resultLen)
+	for k := range result {
+		digits := make([]uint64, len(primes))
+		for i := range primes {
+			digits[i] = residues[i][k]
+		}
+		result[k] = garner(digits, primes, invs, mod)
+	}
+	return result
+}
+
+// reduceUint64 приводит коэффициенты по модулю простого p; при signed коэффициенты
+// трактуются как числа int в дополнительном коде.
+func reduceUint64(s []uint64, p uint64, signed bool) []uint64 {
+	r := make([]uint64, len(s))
+	for i, v := range s {
+		if signed && int64(v) < 0 {
+			r[i] = (p - uint64(-int64(v))%p) % p
+		} else {
+			r[i] = v % p
+		}
+	}
+	return r
+}
+
+// garnerInverses возвращает (m_0*...*m_{i-1})^(-1) mod m_i для каждого модуля.
+func garnerInverses(primes []nttPrime) []uint64 {
+	invs := make([]uint64, len(primes))
+	for i, prime := range primes {
+		prod := uint64(1)
+		for j := 0; j < i; j++ {
+			prod = mulMod(prod, primes[j].p%prime.p, prime.p)
+		}
+		invs[i] = powMod(prod, prime.p-2, prime.p)
+	}
+	return invs
+}
+
+// garner восстанавливает число по вычетам residues[i] по модулям primes[i] и возвращает его по модулю mod.
+// При mod = 0 результат трактуется как знаковое число из (-M/2, M/2), где M - произведение модулей.
+func garner(residues []uint64, primes []nttPrime, invs []uint64, mod uint64) uint64 {
+	// Смешанная система счисления: x = v0 + v1*m0 + v2*m0*m1 + ...
+	v := make([]uint64, len(primes))
+	for i, prime := range primes {
+		prod := uint64(1)
+		acc := uint64(0)
+		for j := 0; j < i; j++ {
+			acc = addMod(acc, mulMod(v[j]%prime.p, prod, prime.p), prime.p)
+			prod = mulMod(prod, primes[j].p%prime.p, prime.p)
+		}
+		v[i] = mulMod(subMod(residues[i], acc, prime.p), invs[i], prime.p)
+	}
+
+	negative := false
+	if mod == 0 {
+		// M/2 в смешанной системе имеет цифры (m_i - 1)/2, так как все модули нечётны
+		for i := len(primes) - 1; i >= 0; i-- {
+			half := (primes[i].p - 1) / 2
+			if v[i] != half {
+				negative = v[i] > half
+				break
+			}
+		}
+	}
+
+	result := uint64(0)
+	prod := uint64(1)
+	for i, prime := range primes {
+		result = addMod(result, mulModRing(reduceRing(v[i], mod), prod, mod), mod)
+		prod = mulModRing(prod, reduceRing(prime.p, mod), mod)
+	}
+	if negative {
+		result = subMod(result, prod, mod)
+	}
+	return result
+}
+
+func reduceRing(x, mod uint64) uint64 {
+	if mod == 0 {
+		return x
+	}
+	return x % mod
+}
+
+// nttConvolve вычисляет циклическую свёртку a и b по модулю prime.p.
+func nttConvolve(a, b []uint64, prime nttPrime) []uint64 {
+	n := nextPOT(len(a) + len(b) - 1)
+	if n > maxNTTLength {
+		panic(fmt.Sprintf("NTT length %d exceeds the maximum %d supported by the primes", n, maxNTTLength))
+	}
+	fa := expandUint64(a, n)
+	fb := expandUint64(b, n)
+	ntt(fa, prime, false)
+	ntt(fb, prime, false)
+	for i := range fa {
+		fa[i] = mulMod(fa[i], fb[i], prime.p)
+	}
+	ntt(fa, prime, true)
+	return fa
+}
+
+// ntt выполняет итеративное преобразование на месте, длина a - степень двойки.
+func ntt(a []uint64, prime nttPrime, invert bool) {
+	n := len(a)
+	p := prime.p
+
+	for i, j := 1, 0; i < n; i++ {
+		bit := n >> 1
+		for ; j&bit != 0; bit >>= 1 {
+			j ^= bit
+		}
+		j ^= bit
+		if i < j {
+			a[i], a[j] = a[j], a[i]
+		}
+	}
+
+	for length := 2; length <= n; length <<= 1 {
+		w := powMod(prime.g, (p-1)/uint64(length), p)
+		if invert {
+			w = powMod(w, p-2, p)
+		}
+		for i := 0; i < n; i += length {
+			wn := uint64(1)
+			for j := 0; j < length/2; j++ {
+				u := a[i+j]
+				v := mulMod(a[i+j+length/2], wn, p)
+				a[i+j] = addMod(u, v, p)
+				a[i+j+length/2] = subMod(u, v, p)
+				wn = mulMod(wn, w, p)
+			}
+		}
+	}
+
+	if invert {
+		nInv := powMod(uint64(n), p-2, p)
+		for i := range a {
+			a[i] = mulMod(a[i], nInv, p)
+		}
+	}
+}
+
+func expandUint64(s []uint64, n int) []uint64 {
+	exp := make([]uint64, max(n, len(s)))
+	copy(exp, s)
+	return exp
+}
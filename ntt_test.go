@@ -0,0 +1,125 @@
+package polygfgo
+
+import (
+	"math/big"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func randomUint64s(rng *rand.Rand, n int, mod uint64) []uint64 {
+	s := make([]uint64, n)
+	for i := range s {
+		if mod == 0 {
+			s[i] = rng.Uint64()
+		} else {
+			s[i] = rng.Uint64() % mod
+		}
+	}
+	return s
+}
+
+// bigConvolution вычисляет произведение через math/big как эталон.
+func bigConvolution(a, b []uint64, mod uint64, signed bool) []uint64 {
+	toBig := func(v uint64) *big.Int {
+		if signed {
+			return big.NewInt(int64(v))
+		}
+		return new(big.Int).SetUint64(v)
+	}
+
+	modulus := new(big.Int).Lsh(big.NewInt(1), 64)
+	if mod != 0 {
+		modulus.SetUint64(mod)
+	}
+
+	result := make([]uint64, len(a)+len(b)-1)
+	for k := range result {
+		sum := new(big.Int)
+		for i := max(0, k-len(b)+1); i <= k && i < len(a); i++ {
+			sum.Add(sum, new(big.Int).Mul(toBig(a[i]), toBig(b[k-i])))
+		}
+		result[k] = sum.Mod(sum, modulus).Uint64()
+	}
+	return result
+}
+
+func TestMulCoefs(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	cases := []struct {
+		name string
+		n, m int
+		mod  uint64
+	}{
+		{"schoolbook with small prime", 20, 17, 101},
+		{"karatsuba with 61-bit prime", 200, 150, 2305843009213693951},
+		{"NTT with 63-bit prime", 700, 600, 9223372036854775783},
+		{"NTT with small prime", 1000, 1000, 3},
+		{"unbalanced NTT", 2000, 40, 1000000007},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			a := randomUint64s(rng, c.n, c.mod)
+			b := randomUint64s(rng, c.m, c.mod)
+
+			got := mulCoefs(a, b, c.mod)
+			want := bigConvolution(a, b, c.mod, false)
+
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Product mismatch for n=%d, m=%d modulo %d", c.n, c.m, c.mod)
+			}
+		})
+	}
+
+	t.Run("integer coefficients are exact modulo 2^64", func(t *testing.T) {
+		a := randomUint64s(rng, 900, 0)
+		b := randomUint64s(rng, 700, 0)
+
+		got := mulCoefs(a, b, 0)
+		want := bigConvolution(a, b, 0, true)
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Product mismatch for signed coefficients")
+		}
+	})
+}
+
+func TestSimpleField_MulLargePrime(t *testing.T) {
+	t.Run("products above 2^53 are computed exactly", func(t *testing.T) {
+		f := SimpleField{2305843009213693951, false}
+		p1 := newPolynomialNoReverse([]int{2305843009213693950, 2305843009213693950})
+		p2 := newPolynomialNoReverse([]int{2305843009213693950, 1})
+
+		got := f.MulPolynomials(p1, p2)
+		want := newPolynomialNoReverse([]int{1, 0, 2305843009213693950})
+
+		if !got.Equals(want) {
+			t.Errorf("Expected %s but got %s", want.Sprint(), got.Sprint())
+		}
+	})
+}
+
+func BenchmarkSimpleField_Mul(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	f := SimpleField{104729, false}
+	p1 := newPolynomialNoReverse(fromUint64(randomUint64s(rng, 4096, 104729)))
+	p2 := newPolynomialNoReverse(fromUint64(randomUint64s(rng, 4096, 104729)))
+
+	for i := 0; i < b.N; i++ {
+		f.MulPolynomials(p1, p2)
+	}
+}
+
+func TestNTTPrimes(t *testing.T) {
+	for _, prime := range nttPrimes {
+		if (prime.p-1)%maxNTTLength != 0 {
+			t.Errorf("Expected 2^41 to divide %d - 1", prime.p)
+		}
+		// Корень степени maxNTTLength должен быть первообразным
+		w := powMod(prime.g, (prime.p-1)/maxNTTLength, prime.p)
+		if powMod(w, maxNTTLength/2, prime.p) == 1 {
+			t.Errorf("Expected %d to generate roots of order 2^41 modulo %d", prime.g, prime.p)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package polygfgo
+
+import (
+	"fmt"
+	"math/bits"
+	"sort"
+)
+
+// Базы, при которых тест Миллера-Рабина детерминирован для всех n < 2^64
+var millerRabinBases = []uint64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37}
+
+// IsPrime проверяет число на простоту детерминированным тестом Миллера-Рабина.
+func IsPrime(n int) bool {
+	if n < 2 {
+		return false
+	}
+	for _, b := range millerRabinBases {
+		if uint64(n)%b == 0 {
+			return uint64(n) == b
+		}
+	}
+
+	un := uint64(n)
+	d, s := un-1, 0
+	for d%2 == 0 {
+		d /= 2
+		s++
+	}
+
+	for _, a := range millerRabinBases {
+		x := powMod(a, d, un)
+		if x == 1 || x == un-1 {
+			continue
+		}
+		composite := true
+		for i := 1; i < s; i++ {
+			x = mulMod(x, x, un)
+			if x == un-1 {
+				composite = false
+				break
+			}
+		}
+		if composite {
+			return false
+		}
+	}
+	return true
+}
+
+// mulMod вычисляет a*b mod m без переполнения через 128-битное произведение.
+func mulMod(a, b, m uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	return bits.Rem64(hi, lo, m)
+}
+
+func powMod(base, exp, m uint64) uint64 {
+	result := uint64(1) % m
+	base %= m
+	for exp > 0 {
+		if exp&1 == 1 {
+			result = mulMod(result, base, m)
+		}
+		base = mulMod(base, base, m)
+		exp >>= 1
+	}
+	return result
+}
+
+// PrimePower - простой делитель числа и его кратность.
+type PrimePower struct {
+	Prime, Exponent int
+}
+
+// trialDivisionLimit - граница пробного деления в Factorize; оставшийся множитель
+// раскладывается ро-методом Полларда.
+const trialDivisionLimit = 1 << 10
+
+// Factorize раскладывает n > 1 на степени простых делителей в порядке возрастания.
+// Малые делители находятся пробным делением, остальные - ро-методом Полларда в варианте Брента.
+func Factorize(n int) []PrimePower {
+	counts := map[int]int{}
+	for d := 2; d < trialDivisionLimit && d*d <= n; d++ {
+		for n%d == 0 {
+			counts[d]++
+			n /= d
+		}
+	}
+	if n > 1 {
+		factorRho(uint64(n), counts)
+	}
+
+	result := make([]PrimePower, 0, len(counts))
+	for prime, exponent := range counts {
+		result = append(result, PrimePower{prime, exponent})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Prime < result[j].Prime
+	})
+	return result
+}
+
+// primeFactors возвращает различные простые делители n в порядке возрастания.
+func primeFactors(n int) []int {
+	factors := []int{}
+	for _, pp := range Factorize(n) {
+		factors = append(factors, pp.Prime)
+	}
+	return factors
+}
+
+func factorRho(n uint64, counts map[int]int) {
+	if n == 1 {
+		return
+	}
+	if IsPrime(int(n)) {
+		counts[int(n)]++
+		return
+	}
+	d := pollardBrent(n)
+	factorRho(d, counts)
+	factorRho(n/d, counts)
+}
+
+// pollardBrent находит нетривиальный делитель составного нечётного n, последовательно
+// перебирая сдвиги c в отображении x -> x^2 + c. Произведения разностей накапливаются
+// блоками, чтобы вычислять НОД реже.
+func pollardBrent(n uint64) uint64 {
+	const block = 128
+	f := func(x, c uint64) uint64 {
+		return addMod(mulMod(x, x, n), c, n)
+	}
+
+	for c := uint64(1); ; c++ {
+		y, q, g := uint64(2), uint64(1), uint64(1)
+		var x, ys uint64
+		for r := 1; g == 1; r *= 2 {
+			x = y
+			for i := 0; i < r; i++ {
+				y = f(y, c)
+			}
+			for k := 0; k < r && g == 1; k += block {
+				ys = y
+				for i := 0; i < min(block, r-k); i++ {
+					y = f(y, c)
+					q = mulMod(q, absDiff(x, y), n)
+				}
+				g = gcd(q, n)
+			}
+		}
+		if g == n {
+			// Блок перескочил через делитель: повторяем его по одному шагу
+			for g = 1; g == 1; {
+				ys = f(ys, c)
+				g = gcd(absDiff(x, ys), n)
+			}
+		}
+		if g != n {
+			return g
+		}
+	}
+}
+
+func absDiff(a, b uint64) uint64 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
+func gcd(a, b uint64) uint64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// Jacobi вычисляет символ Якоби (a/n) для нечётного n > 0. Для простого n это символ Лежандра:
+// 0, если n делит a, 1 для квадратичного вычета и -1 для невычета.
+func Jacobi(a, n int) (int, error) {
+	if n <= 0 || n%2 == 0 {
+		return 0, fmt.Errorf("jacobi symbol is defined only for odd positive n, got %d", n)
+	}
+
+	a = reduceInt(a, n)
+	result := 1
+	for a != 0 {
+		for a%2 == 0 {
+			a /= 2
+			// (2/n) = -1 при n = 3, 5 mod 8
+			if r := n % 8; r == 3 || r == 5 {
+				result = -result
+			}
+		}
+		// Квадратичный закон взаимности: знак меняется, если a = n = 3 mod 4
+		a, n = n, a
+		if a%4 == 3 && n%4 == 3 {
+			result = -result
+		}
+		a %= n
+	}
+	if n != 1 {
+		return 0, nil
+	}
+	return result, nil
+}
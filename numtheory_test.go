@@ -0,0 +1,121 @@
+package polygfgo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIsPrime(t *testing.T) {
+	t.Run("small numbers", func(t *testing.T) {
+		primes := map[int]bool{2: true, 3: true, 5: true, 7: true, 11: true, 13: true}
+		for n := -3; n < 15; n++ {
+			if got, want := IsPrime(n), primes[n]; got != want {
+				t.Errorf("Expected %t but got %t for %d", want, got, n)
+			}
+		}
+	})
+
+	t.Run("large primes and composites", func(t *testing.T) {
+		cases := map[int]bool{
+			104729:              true,
+			199933:              true,
+			2147483647:          true,
+			4611686018427387847: true,
+			9223372036854775783: true,
+			3215031751:          false, // сильное псевдопростое по базам 2, 3, 5, 7
+			3825123056546413051: false,
+			9223372036854775807: false,
+		}
+		for n, want := range cases {
+			if got := IsPrime(n); got != want {
+				t.Errorf("Expected %t but got %t for %d", want, got, n)
+			}
+		}
+	})
+}
+
+func TestPrimeFactors(t *testing.T) {
+	cases := map[int][]int{
+		1:          {},
+		2:          {2},
+		255:        {3, 5, 17},
+		1024:       {2},
+		6561 - 1:   {2, 5, 41},
+		2147483646: {2, 3, 7, 11, 31, 151, 331},
+	}
+	for n, want := range cases {
+		got := primeFactors(n)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v but got %v for %d", want, got, n)
+		}
+	}
+}
+
+func TestFactorize(t *testing.T) {
+	cases := map[int][]PrimePower{
+		1:                                {},
+		97:                               {{97, 1}},
+		1 << 20:                          {{2, 20}},
+		(1 << 61) - 2:                    {{2, 1}, {3, 2}, {5, 2}, {7, 1}, {11, 1}, {13, 1}, {31, 1}, {41, 1}, {61, 1}, {151, 1}, {331, 1}, {1321, 1}},
+		(1 << 62) - 1:                    {{3, 1}, {715827883, 1}, {2147483647, 1}},
+		1000000007 * 998244353:           {{998244353, 1}, {1000000007, 1}},
+		2147483647 * 2147483647:          {{2147483647, 2}},
+		4 * 9 * 1000003 * 1000003 * 1009: {{2, 2}, {3, 2}, {1009, 1}, {1000003, 2}},
+	}
+	for n, want := range cases {
+		got := Factorize(n)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v but got %v for %d", want, got, n)
+		}
+	}
+}
+
+func TestJacobi(t *testing.T) {
+	t.Run("known values", func(t *testing.T) {
+		cases := []struct{ a, n, want int }{
+			{1001, 9907, -1},
+			{19, 45, 1},
+			{8, 21, -1},
+			{5, 21, 1},
+			{30, 7, 1},
+			{-1, 7, -1},
+			{14, 7, 0},
+			{6, 15, 0},
+			{123, 1, 1},
+		}
+		for _, c := range cases {
+			got, err := Jacobi(c.a, c.n)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got != c.want {
+				t.Errorf("Expected %d but got %d for (%d/%d)", c.want, got, c.a, c.n)
+			}
+		}
+	})
+
+	t.Run("agrees with Euler criterion for primes", func(t *testing.T) {
+		for _, p := range []int{3, 11, 101, 1000000007} {
+			for a := 1; a < 50; a++ {
+				want := 1
+				if powMod(uint64(a), uint64(p-1)/2, uint64(p)) == uint64(p-1) {
+					want = -1
+				}
+				if a%p == 0 {
+					want = 0
+				}
+				if got, _ := Jacobi(a, p); got != want {
+					t.Errorf("Expected %d but got %d for (%d/%d)", want, got, a, p)
+				}
+			}
+		}
+	})
+
+	t.Run("even or non-positive modulus", func(t *testing.T) {
+		for _, n := range []int{0, -3, 8} {
+			if _, err := Jacobi(3, n); err == nil {
+				t.Errorf("Expected error for n = %d but got nil", n)
+			}
+		}
+	})
+}
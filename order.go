@@ -0,0 +1,134 @@
+package polygfgo
+
+import (
+	"fmt"
+)
+
+// Order возвращает мультипликативный порядок ненулевого элемента a из GF(p).
+func (f SimpleField) Order(a int) (int, error) {
+	a = reduceInt(a, f.p)
+	if a == 0 {
+		err := fmt.Errorf("zero element of %s has no multiplicative order", f.ToString())
+		tryLog(f.enableLogging, err)
+		return 0, err
+	}
+	return f.order(a, f.p-1, Factorize(f.p-1)), nil
+}
+
+// IsPrimitiveElement проверяет, что a порождает мультипликативную группу GF(p).
+func (f SimpleField) IsPrimitiveElement(a int) bool {
+	a = reduceInt(a, f.p)
+	return a != 0 && f.isPrimitiveElement(a, f.p-1, Factorize(f.p-1))
+}
+
+// FindPrimitiveElement возвращает наименьший порождающий элемент мультипликативной группы GF(p).
+func (f SimpleField) FindPrimitiveElement() int {
+	factors := Factorize(f.p - 1)
+	for a := 1; a < f.p; a++ {
+		if f.isPrimitiveElement(a, f.p-1, factors) {
+			return a
+		}
+	}
+	return 1
+}
+
+func (f SimpleField) order(a, groupOrder int, factors []PrimePower) int {
+	return reduceOrder(groupOrder, factors, func(exp int) bool {
+		return powMod(uint64(a), uint64(exp), uint64(f.p)) == 1
+	})
+}
+
+func (f SimpleField) isPrimitiveElement(a, groupOrder int, factors []PrimePower) bool {
+	for _, pp := range factors {
+		if powMod(uint64(a), uint64(groupOrder/pp.Prime), uint64(f.p)) == 1 {
+			return false
+		}
+	}
+	return true
+}
+
+// Order возвращает мультипликативный порядок ненулевого элемента a. Порядок находится
+// по разложению p^m - 1: из него убираются простые множители, пока a в соответствующей
+// степени остаётся равным единице.
+func (f ExtendedField) Order(a Polynomial) (int, error) {
+	a = f.Normalize(a)
+	if a.isZeroPolynomial() {
+		err := fmt.Errorf("zero element of %s has no multiplicative order", f.ToString())
+		tryLog(f.enableLogging, err)
+		return 0, err
+	}
+	groupOrder, err := multiplicativeOrder(f.p, f.m)
+	if err != nil {
+		tryLog(f.enableLogging, err)
+		return 0, err
+	}
+	return elementOrder(Element{f, a}, groupOrder, Factorize(groupOrder)), nil
+}
+
+// IsPrimitiveElement проверяет, что a порождает мультипликативную группу поля.
+func (f ExtendedField) IsPrimitiveElement(a Polynomial) bool {
+	a = f.Normalize(a)
+	groupOrder, err := multiplicativeOrder(f.p, f.m)
+	if err != nil {
+		tryLog(f.enableLogging, err)
+		return false
+	}
+	return !a.isZeroPolynomial() && isPrimitiveElement(Element{f, a}, groupOrder, Factorize(groupOrder))
+}
+
+// FindPrimitiveElement перебирает элементы поля в порядке возрастания их записи
+// по основанию p и возвращает первый порождающий элемент мультипликативной группы.
+func (f ExtendedField) FindPrimitiveElement() (Polynomial, error) {
+	groupOrder, err := multiplicativeOrder(f.p, f.m)
+	if err != nil {
+		tryLog(f.enableLogging, err)
+		return newZeroPolynomial(), err
+	}
+	return findPrimitiveElement(f, groupOrder, Factorize(groupOrder)).value, nil
+}
+
+// findPrimitiveElement перебирает ненулевые элементы поля с groupOrder + 1 элементами
+// в порядке elementFromIndex и возвращает первый порождающий мультипликативной группы.
+func findPrimitiveElement(field FieldInterface, groupOrder int, factors []PrimePower) Element {
+	for index := 1; index <= groupOrder; index++ {
+		candidate := elementFromIndex(field, index)
+		if isPrimitiveElement(candidate, groupOrder, factors) {
+			return candidate
+		}
+	}
+	return OneElement(field)
+}
+
+// isPrimitiveElement проверяет, что ненулевой элемент не лежит ни в одной максимальной
+// подгруппе: e^(groupOrder/r) != 1 для всех простых делителей r порядка группы.
+func isPrimitiveElement(e Element, groupOrder int, factors []PrimePower) bool {
+	for _, pp := range factors {
+		if power, _ := e.Pow(groupOrder / pp.Prime); power.IsOne() {
+			return false
+		}
+	}
+	return true
+}
+
+// elementOrder находит порядок ненулевого элемента, делящий groupOrder.
+func elementOrder(e Element, groupOrder int, factors []PrimePower) int {
+	return reduceOrder(groupOrder, factors, func(exp int) bool {
+		power, _ := e.Pow(exp)
+		return power.IsOne()
+	})
+}
+
+// reduceOrder находит порядок элемента, делящий groupOrder, убирая из groupOrder простые
+// множители, пока isOne(exp) сообщает, что элемент в степени exp равен единице.
+func reduceOrder(groupOrder int, factors []PrimePower, isOne func(exp int) bool) int {
+	order := groupOrder
+	for _, pp := range factors {
+		for i := 0; i < pp.Exponent; i++ {
+			if !isOne(order / pp.Prime) {
+				break
+			}
+			order /= pp.Prime
+		}
+	}
+	return order
+}
@@ -0,0 +1,120 @@
+package polygfgo
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSimpleField_Order(t *testing.T) {
+	f := SimpleField{7, false}
+
+	t.Run("orders of the elements of GF(7)", func(t *testing.T) {
+		want := map[int]int{1: 1, 2: 3, 3: 6, 4: 3, 5: 6, 6: 2, -1: 2}
+		for a, order := range want {
+			got, err := f.Order(a)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got != order {
+				t.Errorf("Expected %d but got %d for %d", order, got, a)
+			}
+		}
+	})
+
+	t.Run("zero has no order", func(t *testing.T) {
+		if _, err := f.Order(14); err == nil {
+			t.Errorf("Expected error but got nil")
+		}
+	})
+
+	t.Run("primitive elements", func(t *testing.T) {
+		if got := f.FindPrimitiveElement(); got != 3 {
+			t.Errorf("Expected %d but got %d", 3, got)
+		}
+		if !f.IsPrimitiveElement(5) || f.IsPrimitiveElement(2) || f.IsPrimitiveElement(0) {
+			t.Errorf("Expected only 5 to be primitive")
+		}
+	})
+
+	t.Run("large prime", func(t *testing.T) {
+		f := SimpleField{1000000007, false}
+		g := f.FindPrimitiveElement()
+		if g != 5 {
+			t.Errorf("Expected %d but got %d", 5, g)
+		}
+		order, _ := f.Order(g * g % 1000000007)
+		if order != 500000003 {
+			t.Errorf("Expected %d but got %d", 500000003, order)
+		}
+	})
+}
+
+func TestExtendedField_Order(t *testing.T) {
+	t.Run("orders in GF(2^8)", func(t *testing.T) {
+		f := newAESField()
+		want := map[int]int{0x01: 1, 0x02: 51, 0x03: 255}
+		for b, order := range want {
+			got, err := f.Order(byteElement(f, b).value)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got != order {
+				t.Errorf("Expected %d but got %d for %#x", order, got, b)
+			}
+		}
+	})
+
+	t.Run("zero has no order", func(t *testing.T) {
+		f := newAESField()
+		if _, err := f.Order(newZeroPolynomial()); err == nil {
+			t.Errorf("Expected error but got nil")
+		}
+		if f.IsPrimitiveElement(newZeroPolynomial()) {
+			t.Errorf("Expected zero not to be primitive")
+		}
+	})
+
+	t.Run("smallest primitive element of GF(2^8)", func(t *testing.T) {
+		f := newAESField()
+		got, err := f.FindPrimitiveElement()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		want := NewPolynomial([]int{1, 1})
+		if !got.Equals(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+		if !f.IsPrimitiveElement(got) || f.IsPrimitiveElement(NewPolynomial([]int{1, 0})) {
+			t.Errorf("Expected only %s to be primitive", want.ToString())
+		}
+	})
+
+	t.Run("group order with large prime factors", func(t *testing.T) {
+		// 2^62 - 1 = 3 * 715827883 * 2147483647
+		rng := rand.New(rand.NewSource(3))
+		simple := SimpleField{2, false}
+		f := ExtendedField{simple, 2, 62, randomIrreducible(rng, simple, 62), false}
+
+		g, err := f.FindPrimitiveElement()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		a := f.simple.PowModPolynomial(g, 3*715827883, f.generator)
+		got, _ := f.Order(a)
+		if got != 2147483647 {
+			t.Errorf("Expected %d but got %d", 2147483647, got)
+		}
+	})
+
+	t.Run("group order does not fit into int", func(t *testing.T) {
+		generator := NewPolynomial([]int{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 0, 1, 1})
+		f := ExtendedField{SimpleField{2, false}, 2, 64, generator, false}
+		if _, err := f.Order(NewPolynomial([]int{1, 0})); err == nil {
+			t.Errorf("Expected error but got nil")
+		}
+		if _, err := f.FindPrimitiveElement(); err == nil {
+			t.Errorf("Expected error but got nil")
+		}
+	})
+}
@@ -2,8 +2,6 @@ package polygfgo
 
 import (
 	"fmt"
-
-	"github.com/mjibson/go-dsp/fft"
 )
 
 type Polynomial struct {
@@ -119,19 +117,10 @@ func (p Polynomial) Mul(q Polynomial) Polynomial {
 		return p.MulScalar(q.coefs[0])
 	}
 
-	prodlen := p.deg + q.deg + 1
-	potlen := nextPOT(prodlen)
-
-	a := fft.FFT(toComplex128(expand(p.coefs, potlen)))
-	b := fft.FFT(toComplex128(expand(q.coefs, potlen)))
-
-	// Pointwise multiplication.
-	c := make([]complex128, potlen)
-	for i := 0; i < potlen; i++ {
-		c[i] = a[i] * b[i]
-	}
+	// Точное умножение над целыми числами (по модулю 2^64, как и обычная арифметика int)
+	product := mulCoefs(toUint64(p.coefs[:p.deg+1]), toUint64(q.coefs[:q.deg+1]), 0)
 
-	return newPolynomialNoReverse(toInt(fft.IFFT(c))[:prodlen])
+	return newPolynomialNoReverse(fromUint64(product))
 }
 
 func (p Polynomial) MulScalar(alpha int) Polynomial {
@@ -142,6 +131,29 @@ func (p Polynomial) MulScalar(alpha int) Polynomial {
 	return result.Normalize()
 }
 
+// Eval вычисляет значение многочлена в целой точке x по схеме Горнера.
+// Вычисления ведутся без приведения по модулю; для значения в поле см. SimpleField.Eval.
+func (p Polynomial) Eval(x int) int {
+	result := 0
+	for i := p.deg; i >= 0; i-- {
+		result = result*x + p.coefs[i]
+	}
+	return result
+}
+
+// Derivative возвращает формальную производную многочлена над целыми числами.
+func (p Polynomial) Derivative() Polynomial {
+	if p.deg < 1 {
+		return newZeroPolynomial()
+	}
+
+	coefs := make([]int, p.deg)
+	for i := 1; i <= p.deg; i++ {
+		coefs[i-1] = i * p.coefs[i]
+	}
+	return newPolynomialNoReverse(coefs)
+}
+
 func (p Polynomial) Sprint() string {
 	return fmt.Sprint(p.coefs) + fmt.Sprintf("%d:%d", p.len, p.deg)
 }
@@ -445,6 +445,52 @@ func TestMulScalar(t *testing.T) {
 	})
 }
 
+func TestEval(t *testing.T) {
+	t.Run("value of 2x^2 - 3x + 1 at 4", func(t *testing.T) {
+		poly := NewPolynomial([]int{2, -3, 1})
+
+		got := poly.Eval(4)
+		want := 21
+
+		if got != want {
+			t.Errorf("Expected %d but got %d", want, got)
+		}
+	})
+
+	t.Run("value of zero polynomial", func(t *testing.T) {
+		poly := newZeroPolynomial()
+
+		got := poly.Eval(5)
+
+		if got != 0 {
+			t.Errorf("Expected 0 but got %d", got)
+		}
+	})
+}
+
+func TestDerivative(t *testing.T) {
+	t.Run("derivative of 3x^3 - 2x + 5", func(t *testing.T) {
+		poly := NewPolynomial([]int{3, 0, -2, 5})
+
+		got := poly.Derivative()
+		want := NewPolynomial([]int{9, 0, -2})
+
+		if !(got.Equals(want)) {
+			t.Errorf("Expected %s but got %s", want.Sprint(), got.Sprint())
+		}
+	})
+
+	t.Run("derivative of constant", func(t *testing.T) {
+		poly := NewPolynomial([]int{7})
+
+		got := poly.Derivative()
+
+		if !got.isZeroPolynomial() {
+			t.Errorf("Expected zero polynomial but got %s", got.Sprint())
+		}
+	})
+}
+
 func TestSprint(t *testing.T) {
 	t.Run("to string test for {-2, 19, 0, 2301, 0}", func(t *testing.T) {
 		poly := Polynomial{[]int{-2, 19, 0, 2301, 0}, 5, 4}
@@ -0,0 +1,199 @@
+package polygfgo
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"sort"
+)
+
+// chienSearchLimit - наибольший размер поля, для которого корни ищутся перебором
+// всех элементов (поиск Ченя), а не расщеплением gcd(poly, x^q - x).
+const chienSearchLimit = 1 << 10
+
+// Root - корень многочлена и его кратность.
+type Root struct {
+	Value        Element
+	Multiplicity int
+}
+
+// Roots возвращает все корни многочлена из GF(p) с кратностями.
+func (f SimpleField) Roots(poly Polynomial) ([]Root, error) {
+	poly = f.Normalize(poly)
+	coefs := make([]Element, poly.deg+1)
+	for i := range coefs {
+		coefs[i] = NewElementFromInt(f, poly.coefs[i])
+	}
+	roots, err := newFieldPolynomialNoReverse(f, coefs).Roots()
+	if err != nil {
+		tryLog(f.enableLogging, err)
+	}
+	return roots, err
+}
+
+// Roots возвращает все корни многочлена, лежащие в поле его коэффициентов, с кратностями.
+// Для малых полей используется поиск Ченя, для больших - выделение gcd(poly, x^q - x)
+// и его расщепление алгоритмом Рабина. Корни упорядочены по значению.
+func (fp FieldPolynomial) Roots() ([]Root, error) {
+	if fp.IsZero() {
+		return nil, fmt.Errorf("roots of zero polynomial are not defined")
+	}
+
+	poly := fp.Monic()
+	if poly.deg < 1 {
+		return []Root{}, nil
+	}
+
+	q := fieldSize(fp.field)
+	var values []Element
+	if q.IsInt64() && q.Int64() <= chienSearchLimit {
+		values = poly.chienSearch(int(q.Int64()))
+	} else {
+		// Зерно влияет только на порядок расщепления, но не на результат
+		rng := rand.New(rand.NewSource(1))
+		x := newMonomialFieldPolynomial(OneElement(fp.field), 1)
+		values = poly.GCD(x.powQ(poly).Sub(x)).splitLinear(q, rng)
+	}
+
+	roots := make([]Root, 0, len(values))
+	for _, value := range values {
+		linear := newFieldPolynomialNoReverse(fp.field, []Element{value.Neg(), OneElement(fp.field)})
+		multiplicity := 0
+		for rest := poly; ; multiplicity++ {
+			quot, rem, _ := rest.DivMod(linear)
+			if !rem.IsZero() {
+				break
+			}
+			rest = quot
+		}
+		roots = append(roots, Root{value, multiplicity})
+	}
+	sort.Slice(roots, func(i, j int) bool {
+		return lessPolynomial(roots[i].Value.value, roots[j].Value.value)
+	})
+	return roots, nil
+}
+
+// chienSearch перебирает ноль и все степени примитивного элемента alpha, обновляя
+// слагаемые c_i*alpha^(i*j) умножением на alpha^i вместо вычисления по схеме Горнера.
+func (fp FieldPolynomial) chienSearch(q int) []Element {
+	values := []Element{}
+	if fp.Coefficient(0).IsZero() {
+		values = append(values, ZeroElement(fp.field))
+	}
+
+	alpha := findPrimitiveElement(fp.field, q-1, Factorize(q-1))
+	steps := make([]Element, fp.deg+1)
+	terms := make([]Element, fp.deg+1)
+	step := OneElement(fp.field)
+	for i := range steps {
+		steps[i] = step
+		terms[i] = fp.coefs[i]
+		step = step.Mul(alpha)
+	}
+
+	point := OneElement(fp.field)
+	for j := 0; j < q-1; j++ {
+		sum := ZeroElement(fp.field)
+		for i := range terms {
+			sum = sum.Add(terms[i])
+			terms[i] = terms[i].Mul(steps[i])
+		}
+		if sum.IsZero() {
+			values = append(values, point)
+		}
+		point = point.Mul(alpha)
+	}
+	return values
+}
+
+// splitLinear находит корни нормированного многочлена, являющегося произведением
+// различных линейных множителей. Для нечётного q используется gcd с (x + a)^((q-1)/2) - 1,
+// для q = 2^m - gcd со следом Tr(a*x) = a*x + (a*x)^2 + ... + (a*x)^(2^(m-1)).
+func (fp FieldPolynomial) splitLinear(q *big.Int, rng *rand.Rand) []Element {
+	if fp.deg < 1 {
+		return []Element{}
+	}
+	if fp.deg == 1 {
+		return []Element{fp.coefs[0].Neg()}
+	}
+
+	one := newMonomialFieldPolynomial(OneElement(fp.field), 0)
+	exp := new(big.Int).Rsh(new(big.Int).Sub(q, big.NewInt(1)), 1)
+	for {
+		a := randomElement(fp.field, rng)
+
+		var b FieldPolynomial
+		if fp.field.GetPrime() == 2 {
+			term := newMonomialFieldPolynomial(a, 1)
+			b = term
+			for i := 1; i < fp.field.GetDegree(); i++ {
+				_, term, _ = term.Mul(term).DivMod(fp)
+				b = b.Add(term)
+			}
+		} else {
+			shifted := newFieldPolynomialNoReverse(fp.field, []Element{a, OneElement(fp.field)})
+			b = shifted.powMod(exp, fp).Sub(one)
+		}
+
+		g := fp.GCD(b)
+		if g.deg > 0 && g.deg < fp.deg {
+			quot, _, _ := fp.DivMod(g)
+			return append(g.splitLinear(q, rng), quot.splitLinear(q, rng)...)
+		}
+	}
+}
+
+// powMod возводит многочлен в степень exp по модулю mod.
+func (fp FieldPolynomial) powMod(exp *big.Int, mod FieldPolynomial) FieldPolynomial {
+	result := newMonomialFieldPolynomial(OneElement(fp.field), 0)
+	_, current, _ := fp.DivMod(mod)
+
+	for i := 0; i < exp.BitLen(); i++ {
+		if exp.Bit(i) == 1 {
+			_, result, _ = result.Mul(current).DivMod(mod)
+		}
+		current = current.Mul(current)
+		_, current, _ = current.DivMod(mod)
+	}
+
+	_, result, _ = result.DivMod(mod)
+	return result
+}
+
+// powQ возводит многочлен в степень q = p^m по модулю mod, выполняя m возведений в степень p.
+func (fp FieldPolynomial) powQ(mod FieldPolynomial) FieldPolynomial {
+	p := big.NewInt(int64(fp.field.GetPrime()))
+	result := fp
+	for i := 0; i < fp.field.GetDegree(); i++ {
+		result = result.powMod(p, mod)
+	}
+	return result
+}
+
+// fieldSize возвращает число элементов поля p^m.
+func fieldSize(field FieldInterface) *big.Int {
+	return new(big.Int).Exp(big.NewInt(int64(field.GetPrime())), big.NewInt(int64(field.GetDegree())), nil)
+}
+
+// elementFromIndex возвращает элемент, коэффициенты которого - цифры index в системе счисления по основанию p.
+func elementFromIndex(field FieldInterface, index int) Element {
+	p := field.GetPrime()
+	coefs := make([]int, field.GetDegree())
+	for i := range coefs {
+		coefs[i] = index % p
+		index /= p
+	}
+	element, _ := NewElement(field, newPolynomialNoReverse(coefs))
+	return element
+}
+
+// randomElement возвращает случайный элемент поля.
+func randomElement(field FieldInterface, rng *rand.Rand) Element {
+	coefs := make([]int, field.GetDegree())
+	for i := range coefs {
+		coefs[i] = int(rng.Int63n(int64(field.GetPrime())))
+	}
+	element, _ := NewElement(field, newPolynomialNoReverse(coefs))
+	return element
+}
@@ -0,0 +1,164 @@
+package polygfgo
+
+import (
+	"testing"
+)
+
+// polynomialFromRoots возвращает произведение (x - r) по всем значениям roots с учётом повторов.
+func polynomialFromRoots(field FieldInterface, roots ...Element) FieldPolynomial {
+	result := newMonomialFieldPolynomial(OneElement(field), 0)
+	for _, r := range roots {
+		result = result.Mul(newFieldPolynomialNoReverse(field, []Element{r.Neg(), OneElement(field)}))
+	}
+	return result
+}
+
+func checkRoots(t *testing.T, got []Root, want []Root) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("Expected %d roots but got %d", len(want), len(got))
+	}
+	for i := range want {
+		if !got[i].Value.Equal(want[i].Value) || got[i].Multiplicity != want[i].Multiplicity {
+			t.Errorf("Expected %s^%d but got %s^%d", want[i].Value.ToString(), want[i].Multiplicity, got[i].Value.ToString(), got[i].Multiplicity)
+		}
+	}
+}
+
+func TestSimpleField_Eval(t *testing.T) {
+	t.Run("x^2 + 1 at 3 over GF(7)", func(t *testing.T) {
+		f := SimpleField{7, false}
+
+		got := f.Eval(NewPolynomial([]int{1, 0, 1}), 3)
+		want := 3
+
+		if got != want {
+			t.Errorf("Expected %d but got %d", want, got)
+		}
+	})
+
+	t.Run("negative point and coefficients over 2^61 - 1", func(t *testing.T) {
+		f := SimpleField{2305843009213693951, false}
+
+		got := f.Eval(NewPolynomial([]int{-1, 0, 0}), -1000000000000)
+		want := f.p - int(mulMod(1000000000000, 1000000000000, uint64(f.p)))
+
+		if got != want {
+			t.Errorf("Expected %d but got %d", want, got)
+		}
+	})
+}
+
+func TestSimpleField_Roots(t *testing.T) {
+	t.Run("repeated root over GF(7)", func(t *testing.T) {
+		f := SimpleField{7, false}
+		poly := f.MulPolynomials(NewPolynomial([]int{1, -1}), NewPolynomial([]int{1, -1}))
+		poly = f.MulPolynomials(poly, NewPolynomial([]int{1, -3}))
+		poly = f.MulPolynomials(poly, NewPolynomial([]int{1, 0, 1}))
+
+		got, err := f.Roots(poly)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		checkRoots(t, got, []Root{{NewElementFromInt(f, 1), 2}, {NewElementFromInt(f, 3), 1}})
+	})
+
+	t.Run("Rabin splitting over 10^9 + 7", func(t *testing.T) {
+		f := SimpleField{1000000007, false}
+		poly := polynomialFromRoots(f, NewElementFromInt(f, 12345), NewElementFromInt(f, 5), NewElementFromInt(f, 12345), NewElementFromInt(f, 12345))
+		poly = poly.Mul(newFieldPolynomialNoReverse(f, []Element{OneElement(f), ZeroElement(f), OneElement(f)}))
+
+		got, err := poly.Roots()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		checkRoots(t, got, []Root{{NewElementFromInt(f, 5), 1}, {NewElementFromInt(f, 12345), 3}})
+	})
+
+	t.Run("Rabin splitting over 2^61 - 1", func(t *testing.T) {
+		f := SimpleField{2305843009213693951, false}
+		poly := f.MulPolynomials(NewPolynomial([]int{1, -2}), NewPolynomial([]int{1, -3}))
+		poly = f.MulPolynomials(poly, NewPolynomial([]int{1, -1000000000000000}))
+
+		got, err := f.Roots(poly)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		checkRoots(t, got, []Root{{NewElementFromInt(f, 2), 1}, {NewElementFromInt(f, 3), 1}, {NewElementFromInt(f, 1000000000000000), 1}})
+	})
+
+	t.Run("polynomial without roots", func(t *testing.T) {
+		f := SimpleField{3, false}
+
+		got, err := f.Roots(NewPolynomial([]int{1, 0, 1}))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if len(got) != 0 {
+			t.Errorf("Expected no roots but got %v", got)
+		}
+	})
+
+	t.Run("roots of zero polynomial", func(t *testing.T) {
+		f := SimpleField{7, false}
+
+		if _, err := f.Roots(newZeroPolynomial()); err == nil {
+			t.Errorf("Expected error for zero polynomial")
+		}
+	})
+}
+
+func TestFieldPolynomial_Roots(t *testing.T) {
+	t.Run("Chien search over GF(2^8)", func(t *testing.T) {
+		f := newAESField()
+		poly := polynomialFromRoots(f, byteElement(f, 0x53), byteElement(f, 0x00), byteElement(f, 0x53), byteElement(f, 0xCA))
+		poly = poly.Mul(byteFieldPolynomial(f, 0x01, 0x00, 0x01, 0x01))
+
+		got, err := poly.Roots()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		checkRoots(t, got, []Root{{byteElement(f, 0x00), 1}, {byteElement(f, 0x53), 2}, {byteElement(f, 0xCA), 1}})
+	})
+
+	for _, tc := range []struct {
+		name string
+		p, m int
+	}{
+		{"trace splitting over GF(2^11)", 2, 11},
+		{"Rabin splitting over GF(3^7)", 3, 7},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			f, err := NewField(tc.p, tc.m)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			a := elementFromIndex(f, 1000)
+			b := elementFromIndex(f, 77)
+			c := elementFromIndex(f, 2000)
+			poly := polynomialFromRoots(f, a, b, b, c, c, c)
+
+			got, err := poly.Roots()
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(got) != 3 {
+				t.Fatalf("Expected 3 roots but got %d", len(got))
+			}
+			for _, root := range got {
+				if !poly.Eval(root.Value).IsZero() {
+					t.Errorf("Expected %s to be a root", root.Value.ToString())
+				}
+			}
+			if got[0].Multiplicity+got[1].Multiplicity+got[2].Multiplicity != 6 {
+				t.Errorf("Expected total multiplicity 6 but got %v", got)
+			}
+		})
+	}
+}
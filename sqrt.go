@@ -0,0 +1,176 @@
+package polygfgo
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+)
+
+// cipollaRatio задаёт выбор алгоритма извлечения корня при q - 1 = 2^s * t: алгоритм
+// Тонелли-Шенкса выполняет O(s^2) умножений сверх возведения в степень, поэтому при
+// s^2 > cipollaRatio * log2(q) используется алгоритм Чиполлы.
+const cipollaRatio = 8
+
+// Legendre возвращает символ Лежандра (a/p): 0 для нуля, 1 для ненулевого квадрата, -1 для невычета.
+// В GF(2) каждый элемент является квадратом.
+func (f SimpleField) Legendre(a int) int {
+	if f.p == 2 {
+		return reduceInt(a, 2)
+	}
+	symbol, _ := Jacobi(a, f.p)
+	return symbol
+}
+
+// IsSquare проверяет, является ли a квадратом в GF(p).
+func (f SimpleField) IsSquare(a int) bool {
+	return f.Legendre(a) >= 0
+}
+
+// Sqrt возвращает квадратный корень из a в GF(p); из двух корней r и p - r возвращается меньший.
+func (f SimpleField) Sqrt(a int) (int, error) {
+	a = reduceInt(a, f.p)
+	if f.p == 2 || a == 0 {
+		return a, nil
+	}
+	if f.Legendre(a) < 0 {
+		err := fmt.Errorf("%d is not a square in %s", a, f.ToString())
+		tryLog(f.enableLogging, err)
+		return 0, err
+	}
+
+	legendre := func(e Element) int {
+		return f.Legendre(coefAt(e.value, 0))
+	}
+	root := coefAt(sqrtElement(NewElementFromInt(f, a), big.NewInt(int64(f.p)), legendre).value, 0)
+	return min(root, f.p-root), nil
+}
+
+// Legendre возвращает квадратичный характер a: 0 для нуля, 1 для ненулевого квадрата, -1 для невычета.
+// В нечётной характеристике он равен символу Лежандра нормы a в GF(p), в чётной каждый элемент - квадрат.
+func (f ExtendedField) Legendre(a Polynomial) int {
+	a = f.Normalize(a)
+	if a.isZeroPolynomial() {
+		return 0
+	}
+	if f.p == 2 {
+		return 1
+	}
+	symbol, _ := Jacobi(f.Norm(a), f.p)
+	return symbol
+}
+
+// IsSquare проверяет, является ли a квадратом в поле.
+func (f ExtendedField) IsSquare(a Polynomial) bool {
+	return f.Legendre(a) >= 0
+}
+
+// Sqrt возвращает квадратный корень из a. В характеристике 2 корень единственен
+// и равен a^(2^(m-1)), то есть обратному автоморфизму Фробениуса. В нечётной
+// характеристике из двух корней r и -r возвращается меньший по записи коэффициентов.
+func (f ExtendedField) Sqrt(a Polynomial) (Polynomial, error) {
+	a = f.Normalize(a)
+	if a.isZeroPolynomial() {
+		return a, nil
+	}
+	if f.p == 2 {
+		return f.Frobenius(a, -1), nil
+	}
+	if f.Legendre(a) < 0 {
+		err := fmt.Errorf("%s is not a square in %s", a.ToString(), f.ToString())
+		tryLog(f.enableLogging, err)
+		return newZeroPolynomial(), err
+	}
+
+	legendre := func(e Element) int {
+		return f.Legendre(e.value)
+	}
+	root := sqrtElement(Element{f, a}, fieldSize(f), legendre).value
+	if negated := f.SubPolynomials(newZeroPolynomial(), root); lessPolynomial(negated, root) {
+		return negated, nil
+	}
+	return root, nil
+}
+
+// sqrtElement извлекает корень из ненулевого квадрата a в поле нечётной характеристики из q элементов.
+func sqrtElement(a Element, q *big.Int, legendre func(Element) int) Element {
+	// Зерно влияет только на выбор вспомогательных элементов, но не на результат
+	rng := rand.New(rand.NewSource(1))
+	s := new(big.Int).Sub(q, big.NewInt(1)).TrailingZeroBits()
+	if int(s*s) > cipollaRatio*q.BitLen() {
+		return cipolla(a, q, rng, legendre)
+	}
+
+	nonSquare := randomElement(a.field, rng)
+	for legendre(nonSquare) >= 0 {
+		nonSquare = randomElement(a.field, rng)
+	}
+	return tonelliShanks(a, q, nonSquare)
+}
+
+// tonelliShanks извлекает корень алгоритмом Тонелли-Шенкса. При q - 1 = 2^s * t
+// приближение r = a^((t+1)/2) исправляется степенями c = z^t невычета z, пока
+// b = r^2 / a не станет единицей; порядок b уменьшается на каждом шаге.
+func tonelliShanks(a Element, q *big.Int, nonSquare Element) Element {
+	t := new(big.Int).Sub(q, big.NewInt(1))
+	s := int(t.TrailingZeroBits())
+	t.Rsh(t, uint(s))
+
+	c := powElement(nonSquare, t)
+	b := powElement(a, t)
+	r := powElement(a, new(big.Int).Rsh(new(big.Int).Add(t, big.NewInt(1)), 1))
+	for !b.IsOne() {
+		// Наименьшее i, при котором b^(2^i) = 1
+		i := 0
+		for power := b; !power.IsOne(); i++ {
+			power = power.Mul(power)
+		}
+
+		for j := 0; j < s-i-1; j++ {
+			c = c.Mul(c)
+		}
+		s = i
+		r = r.Mul(c)
+		c = c.Mul(c)
+		b = b.Mul(c)
+	}
+	return r
+}
+
+// cipolla извлекает корень алгоритмом Чиполлы: для u, при котором d = u^2 - a - невычет,
+// корень равен (u + w)^((q+1)/2) в расширении GF(q)[w]/(w^2 - d).
+func cipolla(a Element, q *big.Int, rng *rand.Rand, legendre func(Element) int) Element {
+	u := randomElement(a.field, rng)
+	d := u.Mul(u).Sub(a)
+	for legendre(d) >= 0 {
+		u = randomElement(a.field, rng)
+		d = u.Mul(u).Sub(a)
+	}
+
+	// (x1 + y1*w) * (x2 + y2*w) = (x1*x2 + y1*y2*d) + (x1*y2 + x2*y1)*w
+	mul := func(x1, y1, x2, y2 Element) (Element, Element) {
+		return x1.Mul(x2).Add(y1.Mul(y2).Mul(d)), x1.Mul(y2).Add(x2.Mul(y1))
+	}
+
+	exp := new(big.Int).Rsh(new(big.Int).Add(q, big.NewInt(1)), 1)
+	x, y := OneElement(a.field), ZeroElement(a.field)
+	baseX, baseY := u, OneElement(a.field)
+	for i := 0; i < exp.BitLen(); i++ {
+		if exp.Bit(i) == 1 {
+			x, y = mul(x, y, baseX, baseY)
+		}
+		baseX, baseY = mul(baseX, baseY, baseX, baseY)
+	}
+	return x
+}
+
+// powElement возводит элемент в степень exp >= 0, не помещающуюся в int.
+func powElement(e Element, exp *big.Int) Element {
+	result := OneElement(e.field)
+	for i := exp.BitLen() - 1; i >= 0; i-- {
+		result = result.Mul(result)
+		if exp.Bit(i) == 1 {
+			result = result.Mul(e)
+		}
+	}
+	return result
+}
@@ -0,0 +1,151 @@
+package polygfgo
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSimpleField_Sqrt(t *testing.T) {
+	t.Run("all squares of small fields", func(t *testing.T) {
+		// 13, 17 и 41 проверяют алгоритм Тонелли-Шенкса при разной степени двойки в p - 1
+		for _, p := range []int{2, 3, 13, 17, 41, 1031} {
+			f := SimpleField{p, false}
+			squares := 0
+			for a := 0; a < p; a++ {
+				if !f.IsSquare(a) {
+					if _, err := f.Sqrt(a); err == nil {
+						t.Errorf("Expected error for %d in GF(%d) but got nil", a, p)
+					}
+					continue
+				}
+				squares++
+
+				r, err := f.Sqrt(a)
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				if r*r%p != a || r > p-r {
+					t.Errorf("Expected the smaller root of %d in GF(%d) but got %d", a, p, r)
+				}
+			}
+			if want := p/2 + 1; squares != want {
+				t.Errorf("Expected %d squares in GF(%d) but got %d", want, p, squares)
+			}
+		}
+	})
+
+	t.Run("large primes", func(t *testing.T) {
+		// 998244353 - 1 = 2^23 * 119, здесь используется алгоритм Чиполлы
+		rng := rand.New(rand.NewSource(2))
+		for _, p := range []int{1000000007, 998244353, 65537} {
+			f := SimpleField{p, false}
+			for i := 0; i < 20; i++ {
+				x := 1 + rng.Intn(p-1)
+				a := int(mulMod(uint64(x), uint64(x), uint64(p)))
+
+				r, err := f.Sqrt(a)
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				if want := min(x, p-x); r != want {
+					t.Errorf("Expected %d but got %d", want, r)
+				}
+			}
+		}
+	})
+
+	t.Run("Legendre symbol", func(t *testing.T) {
+		f := SimpleField{7, false}
+		want := map[int]int{0: 0, 1: 1, 2: 1, 3: -1, 4: 1, 5: -1, 6: -1, 9: 1}
+		for a, symbol := range want {
+			if got := f.Legendre(a); got != symbol {
+				t.Errorf("Expected %d but got %d for %d", symbol, got, a)
+			}
+		}
+	})
+}
+
+func TestExtendedField_Sqrt(t *testing.T) {
+	t.Run("unique roots in characteristic 2", func(t *testing.T) {
+		f := newAESField()
+		for b := 0; b < 256; b++ {
+			a := byteElement(f, b).value
+			r, err := f.Sqrt(a)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got := f.MulPolynomials(r, r); !got.Equals(a) {
+				t.Errorf("Expected %s but got %s", a.ToString(), got.ToString())
+			}
+			if f.Legendre(a) < 0 {
+				t.Errorf("Expected %s to be a square", a.ToString())
+			}
+		}
+	})
+
+	t.Run("all squares of GF(3^5)", func(t *testing.T) {
+		field, err := NewField(3, 5)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		f := field.(ExtendedField)
+
+		squares := 0
+		for i := 0; i < 243; i++ {
+			a := elementFromIndex(f, i).value
+			if !f.IsSquare(a) {
+				if _, err := f.Sqrt(a); err == nil {
+					t.Errorf("Expected error for %s but got nil", a.ToString())
+				}
+				continue
+			}
+			squares++
+
+			r, err := f.Sqrt(a)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got := f.MulPolynomials(r, r); !got.Equals(a) {
+				t.Errorf("Expected %s but got %s", a.ToString(), got.ToString())
+			}
+		}
+		if squares != 122 {
+			t.Errorf("Expected %d squares but got %d", 122, squares)
+		}
+	})
+
+	t.Run("Legendre symbol agrees with Euler criterion", func(t *testing.T) {
+		field, _ := NewField(5, 3)
+		f := field.(ExtendedField)
+		for i := 1; i < 125; i++ {
+			a := Element{f, elementFromIndex(f, i).value}
+			want := 1
+			if power, _ := a.Pow(62); !power.IsOne() {
+				want = -1
+			}
+			if got := f.Legendre(a.value); got != want {
+				t.Errorf("Expected %d but got %d for %s", want, got, a.ToString())
+			}
+		}
+	})
+
+	t.Run("Cipolla in GF(65537^2)", func(t *testing.T) {
+		// 65537^2 - 1 = 2^17 * 32769; 3 - невычет по модулю 65537
+		simple := SimpleField{65537, false}
+		f := ExtendedField{simple, 65537, 2, NewPolynomial([]int{1, 0, -3}), false}
+		rng := rand.New(rand.NewSource(4))
+		for i := 0; i < 10; i++ {
+			x := randomElement(f, rng).value
+			a := f.MulPolynomials(x, x)
+
+			r, err := f.Sqrt(a)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			negated := f.SubPolynomials(newZeroPolynomial(), x)
+			if !r.Equals(x) && !r.Equals(negated) {
+				t.Errorf("Expected %s but got %s", x.ToString(), r.ToString())
+			}
+		}
+	})
+}
@@ -0,0 +1,122 @@
+package polygfgo
+
+import (
+	"fmt"
+)
+
+// Derivative возвращает формальную производную над GF(p). В характеристике p
+// производная обнуляется у многочленов вида g(x^p).
+func (f SimpleField) Derivative(poly Polynomial) Polynomial {
+	poly = f.Normalize(poly)
+	if poly.deg < 1 {
+		return newZeroPolynomial()
+	}
+
+	coefs := make([]int, poly.deg)
+	for i := 1; i <= poly.deg; i++ {
+		coefs[i-1] = int(mulMod(uint64(poly.coefs[i]), uint64(i%f.p), uint64(f.p)))
+	}
+	return newPolynomialNoReverse(coefs)
+}
+
+// PthRoot для многочлена вида g(x^p) возвращает g, для которого g(x)^p = poly(x).
+// В GF(p) возведение коэффициентов в степень p тождественно, поэтому достаточно
+// взять каждый p-й коэффициент.
+func (f SimpleField) PthRoot(poly Polynomial) (Polynomial, error) {
+	poly = f.Normalize(poly)
+	for i := 0; i <= poly.deg; i++ {
+		if i%f.p != 0 && poly.coefs[i] != 0 {
+			err := fmt.Errorf("polynomial %s is not a p-th power over %s", poly.ToString(), f.ToString())
+			tryLog(f.enableLogging, err)
+			return newZeroPolynomial(), err
+		}
+	}
+	return f.pthRoot(poly), nil
+}
+
+func (f SimpleField) pthRoot(poly Polynomial) Polynomial {
+	coefs := make([]int, poly.deg/f.p+1)
+	for i := range coefs {
+		coefs[i] = poly.coefs[i*f.p]
+	}
+	return newPolynomialNoReverse(coefs)
+}
+
+// SquareFreeDecomposition раскладывает многочлен в произведение попарно взаимно простых
+// бесквадратных нормированных множителей g_i^i (алгоритм Юна с учётом характеристики p).
+// Старший коэффициент в разложение не включается.
+func (f SimpleField) SquareFreeDecomposition(poly Polynomial) ([]Factor, error) {
+	poly = f.Normalize(poly)
+	if poly.isZeroPolynomial() {
+		err := fmt.Errorf("square-free decomposition of zero polynomial is not defined")
+		tryLog(f.enableLogging, err)
+		return nil, err
+	}
+
+	factors := f.yun(f.monic(poly))
+	sortFactors(factors)
+	return factors, nil
+}
+
+// yun выполняет алгоритм Юна для нормированного многочлена.
+// В характеристике p шаг i находит произведение множителей, кратность которых сравнима
+// с i по модулю p; оставшаяся часть является p-й степенью и раскладывается рекурсивно.
+func (f SimpleField) yun(poly Polynomial) []Factor {
+	if poly.deg < 1 {
+		return []Factor{}
+	}
+
+	d := f.Derivative(poly)
+	if d.isZeroPolynomial() {
+		factors := f.yun(f.pthRoot(poly))
+		for i := range factors {
+			factors[i].Multiplicity *= f.p
+		}
+		return factors
+	}
+
+	a := f.monic(f.GCD(poly, d))
+	b := f.exactDiv(poly, a)
+	c := f.exactDiv(d, a)
+	parts := []Factor{}
+	rest := poly
+	for i := 1; b.deg > 0; i++ {
+		e := f.SubPolynomials(c, f.Derivative(b))
+		a = f.monic(f.GCD(b, e))
+		if a.deg > 0 {
+			parts = append(parts, Factor{a, i})
+			for j := 0; j < i; j++ {
+				rest = f.exactDiv(rest, a)
+			}
+		}
+		b = f.exactDiv(b, a)
+		c = f.exactDiv(e, a)
+	}
+	if rest.deg < 1 {
+		return parts
+	}
+
+	// Кратность множителя из parts с номером i равна i + p*k, где k - его кратность в корне из rest
+	result := []Factor{}
+	for _, inner := range f.yun(f.pthRoot(rest)) {
+		h := inner.Poly
+		for i := range parts {
+			common := f.monic(f.GCD(parts[i].Poly, h))
+			if common.deg < 1 {
+				continue
+			}
+			result = append(result, Factor{common, parts[i].Multiplicity + f.p*inner.Multiplicity})
+			parts[i].Poly = f.exactDiv(parts[i].Poly, common)
+			h = f.exactDiv(h, common)
+		}
+		if h.deg > 0 {
+			result = append(result, Factor{h, f.p * inner.Multiplicity})
+		}
+	}
+	for _, part := range parts {
+		if part.Poly.deg > 0 {
+			result = append(result, part)
+		}
+	}
+	return result
+}
@@ -0,0 +1,141 @@
+package polygfgo
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSimpleField_Derivative(t *testing.T) {
+	t.Run("derivative vanishes on x^3 + 1 over GF(3)", func(t *testing.T) {
+		f := SimpleField{3, false}
+
+		got := f.Derivative(NewPolynomial([]int{1, 0, 0, 1}))
+
+		if !got.isZeroPolynomial() {
+			t.Errorf("Expected zero polynomial but got %s", got.ToString())
+		}
+	})
+
+	t.Run("derivative of x^4 + 3x^2 + x over GF(5)", func(t *testing.T) {
+		f := SimpleField{5, false}
+
+		got := f.Derivative(NewPolynomial([]int{1, 0, 3, 1, 0}))
+		want := NewPolynomial([]int{4, 0, 1, 1})
+
+		if !got.Equals(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+	})
+}
+
+func TestSimpleField_PthRoot(t *testing.T) {
+	t.Run("root of x^6 + 2x^3 + 1 over GF(3)", func(t *testing.T) {
+		f := SimpleField{3, false}
+
+		got, err := f.PthRoot(NewPolynomial([]int{1, 0, 0, 2, 0, 0, 1}))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := NewPolynomial([]int{1, 2, 1})
+
+		if !got.Equals(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+	})
+
+	t.Run("polynomial that is not a p-th power", func(t *testing.T) {
+		f := SimpleField{3, false}
+
+		if _, err := f.PthRoot(NewPolynomial([]int{1, 1, 0, 1})); err == nil {
+			t.Errorf("Expected error for x^3 + x^2 + 1")
+		}
+	})
+}
+
+func TestSimpleField_SquareFreeDecomposition(t *testing.T) {
+	t.Run("multiplicities congruent modulo p over GF(2)", func(t *testing.T) {
+		f := SimpleField{2, false}
+		x := NewPolynomial([]int{1, 0})
+		x1 := NewPolynomial([]int{1, 1})
+		q := NewPolynomial([]int{1, 1, 1})
+		poly := newPolynomialNoReverse([]int{1})
+		for _, factor := range []Factor{{x, 1}, {x1, 3}, {q, 6}} {
+			for i := 0; i < factor.Multiplicity; i++ {
+				poly = f.MulPolynomials(poly, factor.Poly)
+			}
+		}
+
+		got, err := f.SquareFreeDecomposition(poly)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := map[string]int{x.ToString(): 1, x1.ToString(): 3, q.ToString(): 6}
+
+		if len(got) != len(want) {
+			t.Fatalf("Expected %d factors but got %d", len(want), len(got))
+		}
+		for _, factor := range got {
+			if want[factor.Poly.ToString()] != factor.Multiplicity {
+				t.Errorf("Unexpected factor %s^%d", factor.Poly.ToString(), factor.Multiplicity)
+			}
+		}
+	})
+
+	t.Run("groups factors of equal multiplicity over GF(5)", func(t *testing.T) {
+		f := SimpleField{5, false}
+		a := f.MulPolynomials(NewPolynomial([]int{1, 1}), NewPolynomial([]int{1, 2}))
+		b := NewPolynomial([]int{1, 0, 2})
+		poly := f.MulPolynomials(a, f.MulPolynomials(b, b))
+		poly = f.MulPolynomials(poly, newPolynomialNoReverse([]int{3}))
+
+		got, err := f.SquareFreeDecomposition(poly)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if len(got) != 2 || !got[0].Poly.Equals(b) || got[0].Multiplicity != 2 || !got[1].Poly.Equals(a) || got[1].Multiplicity != 1 {
+			t.Errorf("Expected (%s)(%s)^2 but got %v", a.ToString(), b.ToString(), got)
+		}
+	})
+
+	t.Run("parts are square-free and multiply back", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(5))
+		for _, p := range []int{2, 3, 5} {
+			f := SimpleField{p, false}
+			for i := 0; i < 20; i++ {
+				base := f.randomPolynomial(rng, 4)
+				poly := f.MulPolynomials(base, f.MulPolynomials(base, f.randomPolynomial(rng, 6)))
+				poly = f.MulPolynomials(poly, f.PowModPolynomial(base, p, NewPolynomial(append([]int{1}, make([]int, 30)...))))
+				if poly.deg < 1 {
+					continue
+				}
+
+				got, err := f.SquareFreeDecomposition(poly)
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				for j, part := range got {
+					if g := f.GCD(part.Poly, f.Derivative(part.Poly)); g.deg > 0 {
+						t.Errorf("Part %s is not square-free over GF(%d)", part.Poly.ToString(), p)
+					}
+					for k := j + 1; k < len(got); k++ {
+						if got[j].Multiplicity == got[k].Multiplicity {
+							t.Errorf("Multiplicity %d repeated over GF(%d)", got[j].Multiplicity, p)
+						}
+					}
+				}
+				if product := expandFactors(f, got); !product.Equals(f.monic(poly)) {
+					t.Errorf("Expected product %s but got %s", f.monic(poly).ToString(), product.ToString())
+				}
+			}
+		}
+	})
+
+	t.Run("decomposition of zero polynomial", func(t *testing.T) {
+		f := SimpleField{7, false}
+
+		if _, err := f.SquareFreeDecomposition(newZeroPolynomial()); err == nil {
+			t.Errorf("Expected error for zero polynomial")
+		}
+	})
+}
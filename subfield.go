@@ -0,0 +1,82 @@
+package polygfgo
+
+import (
+	"fmt"
+)
+
+// Frobenius применяет k-ю степень автоморфизма Фробениуса: a -> a^(p^k).
+// Так как a^(p^m) = a, показатель k берётся по модулю m; допускаются отрицательные k.
+func (f ExtendedField) Frobenius(a Polynomial, k int) Polynomial {
+	k = reduceInt(k, f.m)
+	result := f.Normalize(a)
+	for i := 0; i < k; i++ {
+		result = f.frobenius(result)
+	}
+	return result
+}
+
+// InSubfield проверяет, лежит ли a в подполе GF(p^d), то есть выполняется ли a^(p^d) = a.
+// Степень d должна делить m.
+func (f ExtendedField) InSubfield(a Polynomial, d int) (bool, error) {
+	if d < 1 || f.m%d != 0 {
+		err := fmt.Errorf("GF(%d^%d) is not a subfield of %s", f.p, d, f.ToString())
+		tryLog(f.enableLogging, err)
+		return false, err
+	}
+	return f.Frobenius(a, d).Equals(f.Normalize(a)), nil
+}
+
+// Embedding - вложение поля source в поле target, задаваемое образом корня
+// порождающего многочлена source.
+type Embedding struct {
+	source, target ExtendedField
+	image          Polynomial
+}
+
+// SubfieldEmbedding строит вложение поля sub = GF(p^d) в GF(p^m), d | m. Образом корня
+// порождающего многочлена sub выбирается наименьший из его корней в GF(p^m).
+func (f ExtendedField) SubfieldEmbedding(sub ExtendedField) (Embedding, error) {
+	if sub.p != f.p || f.m%sub.m != 0 {
+		err := fmt.Errorf("%s is not a subfield of %s", sub.ToString(), f.ToString())
+		tryLog(f.enableLogging, err)
+		return Embedding{}, err
+	}
+
+	coefs := make([]Element, sub.generator.deg+1)
+	for i := range coefs {
+		coefs[i] = NewElementFromInt(f, sub.generator.coefs[i])
+	}
+	roots, err := newFieldPolynomialNoReverse(f, coefs).Roots()
+	if err != nil {
+		return Embedding{}, err
+	}
+	if len(roots) == 0 {
+		err := fmt.Errorf("generator %s of %s has no roots in %s", sub.generator.ToString(), sub.ToString(), f.ToString())
+		tryLog(f.enableLogging, err)
+		return Embedding{}, err
+	}
+	return Embedding{sub, f, roots[0].Value.value}, nil
+}
+
+func (e Embedding) Source() ExtendedField {
+	return e.source
+}
+
+func (e Embedding) Target() ExtendedField {
+	return e.target
+}
+
+// Image возвращает образ корня порождающего многочлена source.
+func (e Embedding) Image() Polynomial {
+	return e.image
+}
+
+// Apply переводит элемент source в target, подставляя образ корня в многочлен a по схеме Горнера.
+func (e Embedding) Apply(a Polynomial) Polynomial {
+	a = e.source.Normalize(a)
+	result := newZeroPolynomial()
+	for i := a.deg; i >= 0; i-- {
+		result = e.target.AddPolynomials(e.target.MulPolynomials(result, e.image), newPolynomialNoReverse([]int{a.coefs[i]}))
+	}
+	return result
+}
@@ -0,0 +1,97 @@
+package polygfgo
+
+import (
+	"testing"
+)
+
+func TestExtendedField_Frobenius(t *testing.T) {
+	f := newAESField()
+	a := NewPolynomial([]int{1, 0, 1, 1, 0, 1})
+
+	t.Run("Frobenius of order m is the identity", func(t *testing.T) {
+		if got := f.Frobenius(a, 8); !got.Equals(a) {
+			t.Errorf("Expected %s but got %s", a.ToString(), got.ToString())
+		}
+	})
+
+	t.Run("powers compose and invert", func(t *testing.T) {
+		got := f.Frobenius(f.Frobenius(a, 3), 2)
+		want := f.Frobenius(a, 5)
+		if !got.Equals(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+
+		if back := f.Frobenius(f.Frobenius(a, 3), -3); !back.Equals(a) {
+			t.Errorf("Expected %s but got %s", a.ToString(), back.ToString())
+		}
+	})
+
+	t.Run("first power is squaring in characteristic 2", func(t *testing.T) {
+		got := f.Frobenius(a, 1)
+		want := f.MulPolynomials(a, a)
+
+		if !got.Equals(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+	})
+}
+
+func TestExtendedField_InSubfield(t *testing.T) {
+	f := newAESField()
+
+	t.Run("element of order 15 lies in GF(16) but not in GF(4)", func(t *testing.T) {
+		a := f.simple.PowModPolynomial(NewPolynomial([]int{1, 1}), 17, f.generator)
+
+		if in, _ := f.InSubfield(a, 4); !in {
+			t.Errorf("Expected %s to lie in GF(2^4)", a.ToString())
+		}
+		if in, _ := f.InSubfield(a, 2); in {
+			t.Errorf("Expected %s not to lie in GF(2^2)", a.ToString())
+		}
+	})
+
+	t.Run("degree not dividing m", func(t *testing.T) {
+		if _, err := f.InSubfield(NewPolynomial([]int{1}), 3); err == nil {
+			t.Errorf("Expected error for GF(2^3)")
+		}
+	})
+}
+
+func TestExtendedField_SubfieldEmbedding(t *testing.T) {
+	f := newAESField()
+	sub := ExtendedField{SimpleField{2, false}, 2, 4, NewPolynomial([]int{1, 0, 0, 1, 1}), false}
+
+	embedding, err := f.SubfieldEmbedding(sub)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	t.Run("embedding preserves arithmetic", func(t *testing.T) {
+		for a := 0; a < 16; a++ {
+			for b := 0; b < 16; b++ {
+				pa, pb := elementFromIndex(sub, a).value, elementFromIndex(sub, b).value
+
+				if got, want := embedding.Apply(sub.MulPolynomials(pa, pb)), f.MulPolynomials(embedding.Apply(pa), embedding.Apply(pb)); !got.Equals(want) {
+					t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+				}
+				if got, want := embedding.Apply(sub.AddPolynomials(pa, pb)), f.AddPolynomials(embedding.Apply(pa), embedding.Apply(pb)); !got.Equals(want) {
+					t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+				}
+			}
+		}
+	})
+
+	t.Run("image lies in the subfield", func(t *testing.T) {
+		if in, _ := f.InSubfield(embedding.Image(), 4); !in {
+			t.Errorf("Expected %s to lie in GF(2^4)", embedding.Image().ToString())
+		}
+	})
+
+	t.Run("field that is not a subfield", func(t *testing.T) {
+		other := ExtendedField{SimpleField{2, false}, 2, 3, NewPolynomial([]int{1, 0, 1, 1}), false}
+
+		if _, err := f.SubfieldEmbedding(other); err == nil {
+			t.Errorf("Expected error for GF(2^3)")
+		}
+	})
+}
@@ -0,0 +1,153 @@
+package polygfgo
+
+import (
+	"fmt"
+)
+
+// TowerField - расширение GF(q^k) поля base = GF(q) по неприводимому над base многочлену
+// generator степени k. Поле base само может быть расширенным полем или башней, что позволяет
+// строить составные поля вида GF(((2^2)^2)^2) и башни GF(p^12).
+//
+// Элемент a_0 + a_1*y + ... + a_(k-1)*y^(k-1), где a_i - элементы base степени меньше m
+// над GF(p), хранится как многочлен над GF(p): коэффициент при x^j элемента a_i
+// записан в позиции i*m + j. Так элементы башни совместимы с Element и FieldPolynomial.
+type TowerField struct {
+	base          FieldInterface
+	k             int
+	generator     FieldPolynomial
+	enableLogging bool
+}
+
+// NewTowerField строит расширение поля base по многочлену generator над base.
+// Многочлен приводится к нормированному виду и должен быть неприводимым степени не меньше 2.
+func NewTowerField(base FieldInterface, generator FieldPolynomial, enableLogging bool) (TowerField, error) {
+	if !sameField(base, generator.field) {
+		err := fmt.Errorf("generator %s is not a polynomial over %s", generator.ToString(), base.ToString())
+		tryLog(enableLogging, err)
+		return TowerField{}, err
+	}
+	if generator.deg < 2 {
+		err := fmt.Errorf("%w: got %d", ErrGeneratorDegree, generator.deg)
+		tryLog(enableLogging, err)
+		return TowerField{}, err
+	}
+	if !generator.IsIrreducible() {
+		err := fmt.Errorf("%w: %s over %s", ErrGeneratorReducible, generator.ToString(), base.ToString())
+		tryLog(enableLogging, err)
+		return TowerField{}, err
+	}
+
+	return TowerField{base, generator.deg, generator.Monic(), enableLogging}, nil
+}
+
+func (f TowerField) GetPrime() int {
+	return f.base.GetPrime()
+}
+
+// GetDegree возвращает степень башни над простым полем: k * [base : GF(p)].
+func (f TowerField) GetDegree() int {
+	return f.k * f.base.GetDegree()
+}
+
+// GetIrreducible возвращает порождающий многочлен над base в развёрнутой записи:
+// коэффициент при y^i занимает позиции i*m, ..., i*m + m - 1, где m = [base : GF(p)].
+// IsIrreducible и GCD трактуют свои аргументы в той же записи, как многочлены над base.
+func (f TowerField) GetIrreducible() Polynomial {
+	return f.flatten(f.generator)
+}
+
+func (f TowerField) Base() FieldInterface {
+	return f.base
+}
+
+func (f TowerField) Generator() FieldPolynomial {
+	return f.generator
+}
+
+// Normalize приводит многочлен над GF(p) к каноническому представлению элемента башни.
+func (f TowerField) Normalize(poly Polynomial) Polynomial {
+	return f.flatten(f.lift(poly))
+}
+
+func (f TowerField) AddPolynomials(p1, p2 Polynomial) Polynomial {
+	return f.flatten(f.lift(p1).Add(f.lift(p2)))
+}
+
+func (f TowerField) SubPolynomials(p1, p2 Polynomial) Polynomial {
+	return f.flatten(f.lift(p1).Sub(f.lift(p2)))
+}
+
+func (f TowerField) MulPolynomials(p1, p2 Polynomial) Polynomial {
+	_, product, _ := f.lift(p1).Mul(f.lift(p2)).DivMod(f.generator)
+	return f.flatten(product)
+}
+
+// DivPolynomials, как и в ExtendedField, возвращает нулевое частное и p1 * p2^(-1) в качестве остатка.
+func (f TowerField) DivPolynomials(p1, p2 Polynomial) (Polynomial, Polynomial, error) {
+	inverse, err := f.InvPolynomial(p2)
+	if err != nil {
+		return newZeroPolynomial(), newZeroPolynomial(), err
+	}
+	return newZeroPolynomial(), f.MulPolynomials(p1, inverse), nil
+}
+
+// InvPolynomial находит обратный элемент расширенным алгоритмом Евклида над base.
+func (f TowerField) InvPolynomial(poly Polynomial) (Polynomial, error) {
+	a := f.lift(poly)
+	if a.IsZero() {
+		err := fmt.Errorf("zero element of %s has no inverse", f.ToString())
+		tryLog(f.enableLogging, err)
+		return newZeroPolynomial(), err
+	}
+
+	_, s, _ := a.ExtendedGCD(f.generator)
+	return f.flatten(s), nil
+}
+
+// IsIrreducible проверяет неприводимость над base многочлена в развёрнутой записи, см. GetIrreducible.
+func (f TowerField) IsIrreducible(poly Polynomial) bool {
+	return f.split(poly).IsIrreducible()
+}
+
+// GCD возвращает нормированный НОД над base многочленов в развёрнутой записи, см. GetIrreducible.
+func (f TowerField) GCD(p1, p2 Polynomial) Polynomial {
+	return f.flatten(f.split(p1).GCD(f.split(p2)))
+}
+
+func (f TowerField) ToString() string {
+	return fmt.Sprintf("(%s)^%d mod %s", f.base.ToString(), f.k, f.generator.ToString())
+}
+
+// lift возвращает приведённый по модулю generator многочлен над base, см. split.
+func (f TowerField) lift(poly Polynomial) FieldPolynomial {
+	result := f.split(poly)
+	if result.deg >= f.k {
+		_, result, _ = result.DivMod(f.generator)
+	}
+	return result
+}
+
+// split разбивает коэффициенты на блоки по m = [base : GF(p)]: i-й блок - коэффициент при y^i.
+func (f TowerField) split(poly Polynomial) FieldPolynomial {
+	m := f.base.GetDegree()
+	coefs := make([]Element, (poly.deg+m)/m)
+	for i := range coefs {
+		chunk := make([]int, m)
+		for j := range chunk {
+			chunk[j] = coefAt(poly, i*m+j)
+		}
+		coefs[i] = Element{f.base, f.base.AddPolynomials(newPolynomialNoReverse(chunk), newZeroPolynomial())}
+	}
+
+	return newFieldPolynomialNoReverse(f.base, coefs)
+}
+
+// flatten записывает многочлен над base в виде многочлена над GF(p).
+func (f TowerField) flatten(fp FieldPolynomial) Polynomial {
+	m := f.base.GetDegree()
+	coefs := make([]int, (fp.deg+1)*m)
+	for i, c := range fp.coefs {
+		copy(coefs[i*m:], c.value.coefs[:c.value.deg+1])
+	}
+	return newPolynomialNoReverse(coefs)
+}
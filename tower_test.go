@@ -0,0 +1,213 @@
+package polygfgo
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+// constElement возвращает элемент поля field с заданным многочленом-значением.
+func constElement(field FieldInterface, coefs ...int) Element {
+	e, _ := NewElement(field, newPolynomialNoReverse(coefs))
+	return e
+}
+
+// newCompositeAESField строит GF(((2^2)^2)^2): y^2 + y + 1, z^2 + z + y, w^2 + w + lambda.
+func newCompositeAESField(t *testing.T) (TowerField, TowerField) {
+	t.Helper()
+	gf4 := ExtendedField{SimpleField{2, false}, 2, 2, NewPolynomial([]int{1, 1, 1}), false}
+	gf16, err := NewTowerField(gf4, newFieldPolynomialNoReverse(gf4, []Element{constElement(gf4, 0, 1), OneElement(gf4), OneElement(gf4)}), false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for index := 1; index < 16; index++ {
+		lambda := elementFromIndex(gf16, index)
+		generator := newFieldPolynomialNoReverse(gf16, []Element{lambda, OneElement(gf16), OneElement(gf16)})
+		if gf256, err := NewTowerField(gf16, generator, false); err == nil {
+			return gf16, gf256
+		}
+	}
+	t.Fatalf("No irreducible w^2 + w + lambda over %s", gf16.ToString())
+	return TowerField{}, TowerField{}
+}
+
+func TestTowerField_CompositeAES(t *testing.T) {
+	gf16, gf256 := newCompositeAESField(t)
+
+	t.Run("degrees over GF(2)", func(t *testing.T) {
+		if gf16.GetDegree() != 4 || gf256.GetDegree() != 8 {
+			t.Errorf("Expected degrees 4 and 8 but got %d and %d", gf16.GetDegree(), gf256.GetDegree())
+		}
+	})
+
+	t.Run("every nonzero element is invertible", func(t *testing.T) {
+		for index := 1; index < 256; index++ {
+			a := elementFromIndex(gf256, index)
+			inverse, err := a.Inv()
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if got := a.Mul(inverse); !got.IsOne() {
+				t.Errorf("Expected 1 but got %s", got.ToString())
+			}
+		}
+	})
+
+	t.Run("multiplicative group is cyclic of order 255", func(t *testing.T) {
+		found := false
+		for index := 2; index < 256 && !found; index++ {
+			a := elementFromIndex(gf256, index)
+			if power, _ := a.Pow(255); !power.IsOne() {
+				t.Fatalf("Expected a^255 = 1 for %s", a.ToString())
+			}
+			p3, _ := a.Pow(85)
+			p5, _ := a.Pow(51)
+			p17, _ := a.Pow(15)
+			found = !p3.IsOne() && !p5.IsOne() && !p17.IsOne()
+		}
+
+		if !found {
+			t.Errorf("Expected a primitive element")
+		}
+	})
+
+	t.Run("tower generator has its roots in the tower", func(t *testing.T) {
+		coefs := make([]Element, gf16.generator.deg+1)
+		for i, c := range gf16.generator.coefs {
+			coefs[i] = Element{gf16, c.value}
+		}
+
+		roots, err := newFieldPolynomialNoReverse(gf16, coefs).Roots()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if len(roots) != 2 {
+			t.Errorf("Expected 2 roots but got %d", len(roots))
+		}
+	})
+}
+
+func TestTowerField_PairingTower(t *testing.T) {
+	const p = 7
+	gf49 := ExtendedField{SimpleField{p, false}, p, 2, NewPolynomial([]int{1, 0, 1}), false}
+
+	// Ищем xi = u + c, для которого v^3 - xi и w^2 - v неприводимы
+	var gf12 TowerField
+	for c := 0; c < p && gf12.k == 0; c++ {
+		xi := constElement(gf49, c, 1)
+		gf6, err := NewTowerField(gf49, newFieldPolynomialNoReverse(gf49, []Element{xi.Neg(), ZeroElement(gf49), ZeroElement(gf49), OneElement(gf49)}), false)
+		if err != nil {
+			continue
+		}
+		v := constElement(gf6, 0, 0, 1)
+		gf12, _ = NewTowerField(gf6, newFieldPolynomialNoReverse(gf6, []Element{v.Neg(), ZeroElement(gf6), OneElement(gf6)}), false)
+	}
+	if gf12.k == 0 {
+		t.Fatalf("No suitable xi for GF(%d^12)", p)
+	}
+
+	rng := rand.New(rand.NewSource(1))
+
+	t.Run("field axioms on random elements", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			a, b, c := randomElement(gf12, rng), randomElement(gf12, rng), randomElement(gf12, rng)
+
+			if got, want := a.Mul(b.Add(c)), a.Mul(b).Add(a.Mul(c)); !got.Equal(want) {
+				t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+			}
+			if got, want := a.Mul(b).Mul(c), a.Mul(b.Mul(c)); !got.Equal(want) {
+				t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+			}
+			if a.IsZero() {
+				continue
+			}
+			if quot, _ := a.Mul(b).Div(a); !quot.Equal(b) {
+				t.Errorf("Expected %s but got %s", b.ToString(), quot.ToString())
+			}
+		}
+	})
+
+	t.Run("Frobenius of order 12 is the identity", func(t *testing.T) {
+		a := randomElement(gf12, rng)
+		power := a
+		for i := 0; i < 12; i++ {
+			power, _ = power.Pow(p)
+		}
+
+		if !power.Equal(a) {
+			t.Errorf("Expected %s but got %s", a.ToString(), power.ToString())
+		}
+	})
+}
+
+func TestNewTowerField_Errors(t *testing.T) {
+	gf4 := ExtendedField{SimpleField{2, false}, 2, 2, NewPolynomial([]int{1, 1, 1}), false}
+
+	t.Run("reducible generator", func(t *testing.T) {
+		y := constElement(gf4, 0, 1)
+		// (z + y)(z + y + 1) = z^2 + z + y^2 + y = z^2 + z + 1
+		generator := newFieldPolynomialNoReverse(gf4, []Element{OneElement(gf4), OneElement(gf4), OneElement(gf4)})
+
+		_, err := NewTowerField(gf4, generator, false)
+		if !errors.Is(err, ErrGeneratorReducible) {
+			t.Errorf("Expected ErrGeneratorReducible but got %v", err)
+		}
+		if roots, _ := generator.Roots(); len(roots) != 2 || !roots[0].Value.Add(roots[1].Value).IsOne() || (!roots[0].Value.Equal(y) && !roots[1].Value.Equal(y)) {
+			t.Errorf("Expected roots y and y + 1 but got %v", roots)
+		}
+	})
+
+	t.Run("generator over another field", func(t *testing.T) {
+		other := SimpleField{3, false}
+		generator := newFieldPolynomialNoReverse(other, []Element{OneElement(other), ZeroElement(other), OneElement(other)})
+
+		if _, err := NewTowerField(gf4, generator, false); err == nil {
+			t.Errorf("Expected error for generator over GF(3)")
+		}
+	})
+
+	t.Run("linear generator", func(t *testing.T) {
+		generator := newFieldPolynomialNoReverse(gf4, []Element{OneElement(gf4), OneElement(gf4)})
+
+		if _, err := NewTowerField(gf4, generator, false); !errors.Is(err, ErrGeneratorDegree) {
+			t.Errorf("Expected ErrGeneratorDegree but got %v", err)
+		}
+	})
+}
+
+func TestTowerField_PolynomialsOverBase(t *testing.T) {
+	gf16, _ := newCompositeAESField(t)
+	gf4 := gf16.Base()
+	y := constElement(gf4, 0, 1)
+	linear := func(c Element) FieldPolynomial {
+		return newFieldPolynomialNoReverse(gf4, []Element{c, OneElement(gf4)})
+	}
+
+	t.Run("defining polynomial is flattened generator", func(t *testing.T) {
+		// z^2 + z + y: коэффициенты y, 1, 1 занимают по два разряда
+		want := NewPolynomial([]int{1, 0, 1, 1, 0})
+		if got := gf16.GetIrreducible(); !got.Equals(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+		if !gf16.IsIrreducible(gf16.GetIrreducible()) {
+			t.Errorf("Expected defining polynomial to be irreducible over %s", gf4.ToString())
+		}
+	})
+
+	t.Run("reducibility and gcd are computed over the base field", func(t *testing.T) {
+		// z^2 + z + 1 = (z + y)(z + y + 1) над GF(4)
+		a := linear(y).Mul(linear(y.Add(OneElement(gf4))))
+		b := linear(y).Mul(linear(OneElement(gf4)))
+		if gf16.IsIrreducible(gf16.flatten(a)) {
+			t.Errorf("Expected %s to be reducible over %s", a.ToString(), gf4.ToString())
+		}
+
+		want := gf16.flatten(linear(y))
+		if got := gf16.GCD(gf16.flatten(a), gf16.flatten(b)); !got.Equals(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+	})
+}
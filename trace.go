@@ -0,0 +1,72 @@
+package polygfgo
+
+import (
+	"fmt"
+)
+
+// Conjugates возвращает различные сопряжённые элемента a: a, a^p, a^(p^2), ...
+// Их число равно степени минимального многочлена a.
+func (f ExtendedField) Conjugates(a Polynomial) []Polynomial {
+	a = f.Normalize(a)
+	conjugates := []Polynomial{a}
+	for c := f.frobenius(a); !c.Equals(a); c = f.frobenius(c) {
+		conjugates = append(conjugates, c)
+	}
+	return conjugates
+}
+
+// MinimalPolynomial возвращает нормированный многочлен наименьшей степени над GF(p),
+// корнем которого является a: произведение (x - c) по всем сопряжённым c.
+func (f ExtendedField) MinimalPolynomial(a Polynomial) Polynomial {
+	product := newMonomialFieldPolynomial(OneElement(f), 0)
+	for _, c := range f.Conjugates(a) {
+		product = product.Mul(newFieldPolynomialNoReverse(f, []Element{{f, f.SubPolynomials(newZeroPolynomial(), c)}, OneElement(f)}))
+	}
+
+	// Коэффициенты произведения лежат в простом подполе
+	coefs := make([]int, product.deg+1)
+	for i, c := range product.coefs {
+		coefs[i] = coefAt(c.value, 0)
+	}
+	return newPolynomialNoReverse(coefs)
+}
+
+// Trace возвращает абсолютный след a + a^p + ... + a^(p^(m-1)), лежащий в GF(p).
+func (f ExtendedField) Trace(a Polynomial) int {
+	trace, _ := f.RelativeTrace(a, 1)
+	return coefAt(trace, 0)
+}
+
+// RelativeTrace возвращает след a в подполе GF(p^d): a + a^(p^d) + ... + a^(p^(m-d)).
+// Степень d должна делить m.
+func (f ExtendedField) RelativeTrace(a Polynomial, d int) (Polynomial, error) {
+	if d < 1 || f.m%d != 0 {
+		err := fmt.Errorf("GF(%d^%d) is not a subfield of %s", f.p, d, f.ToString())
+		tryLog(f.enableLogging, err)
+		return newZeroPolynomial(), err
+	}
+
+	term := f.Normalize(a)
+	trace := term
+	for i := 1; i < f.m/d; i++ {
+		term = f.Frobenius(term, d)
+		trace = f.AddPolynomials(trace, term)
+	}
+	return trace, nil
+}
+
+// Norm возвращает норму a * a^p * ... * a^(p^(m-1)), лежащую в GF(p).
+func (f ExtendedField) Norm(a Polynomial) int {
+	term := f.Normalize(a)
+	norm := term
+	for i := 1; i < f.m; i++ {
+		term = f.frobenius(term)
+		norm = f.MulPolynomials(norm, term)
+	}
+	return coefAt(norm, 0)
+}
+
+// frobenius возводит элемент в степень p.
+func (f ExtendedField) frobenius(a Polynomial) Polynomial {
+	return f.simple.PowModPolynomial(a, f.p, f.generator)
+}
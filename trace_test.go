@@ -0,0 +1,96 @@
+package polygfgo
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestExtendedField_MinimalPolynomial(t *testing.T) {
+	f := newAESField()
+
+	t.Run("minimal polynomial of x is the generator", func(t *testing.T) {
+		got := f.MinimalPolynomial(NewPolynomial([]int{1, 0}))
+
+		if !got.Equals(f.generator) {
+			t.Errorf("Expected %s but got %s", f.generator.ToString(), got.ToString())
+		}
+	})
+
+	t.Run("element of order 3 lies in GF(4)", func(t *testing.T) {
+		a := f.simple.PowModPolynomial(NewPolynomial([]int{1, 1}), 85, f.generator)
+
+		got := f.MinimalPolynomial(a)
+		want := NewPolynomial([]int{1, 1, 1})
+
+		if !got.Equals(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+		if conjugates := f.Conjugates(a); len(conjugates) != 2 {
+			t.Errorf("Expected 2 conjugates but got %d", len(conjugates))
+		}
+	})
+
+	t.Run("minimal polynomial of a prime field element", func(t *testing.T) {
+		field, _ := NewField(5, 3)
+		g := field.(ExtendedField)
+
+		got := g.MinimalPolynomial(NewPolynomial([]int{3}))
+		want := NewPolynomial([]int{1, 2})
+
+		if !got.Equals(want) {
+			t.Errorf("Expected %s but got %s", want.ToString(), got.ToString())
+		}
+	})
+}
+
+func TestExtendedField_TraceNorm(t *testing.T) {
+	field, err := NewField(3, 4)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	f := field.(ExtendedField)
+	rng := rand.New(rand.NewSource(1))
+
+	t.Run("trace is additive and norm is multiplicative", func(t *testing.T) {
+		for i := 0; i < 20; i++ {
+			a, b := f.simple.randomPolynomial(rng, 4), f.simple.randomPolynomial(rng, 4)
+
+			if got, want := f.Trace(f.AddPolynomials(a, b)), (f.Trace(a)+f.Trace(b))%3; got != want {
+				t.Errorf("Expected %d but got %d", want, got)
+			}
+			if got, want := f.Norm(f.MulPolynomials(a, b)), f.Norm(a)*f.Norm(b)%3; got != want {
+				t.Errorf("Expected %d but got %d", want, got)
+			}
+		}
+	})
+
+	t.Run("trace and norm of a prime field element", func(t *testing.T) {
+		a := NewPolynomial([]int{2})
+
+		if got := f.Trace(a); got != 2 {
+			t.Errorf("Expected 2 but got %d", got)
+		}
+		if got := f.Norm(a); got != 1 {
+			t.Errorf("Expected 1 but got %d", got)
+		}
+	})
+
+	t.Run("relative trace lies in GF(9)", func(t *testing.T) {
+		a := f.simple.randomPolynomial(rng, 4)
+
+		got, err := f.RelativeTrace(a, 2)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if fixed := f.frobenius(f.frobenius(got)); !fixed.Equals(got) {
+			t.Errorf("Expected %s to be fixed by x -> x^9 but got %s", got.ToString(), fixed.ToString())
+		}
+	})
+
+	t.Run("relative trace to a non-subfield", func(t *testing.T) {
+		if _, err := f.RelativeTrace(NewPolynomial([]int{1}), 3); err == nil {
+			t.Errorf("Expected error for GF(3^3)")
+		}
+	})
+}
@@ -57,32 +57,52 @@ func isPOT(n int) bool {
 	return n&(n-1) == 0 && n >= 1
 }
 
-func toComplex128(s []int) []complex128 {
-	c := make([]complex128, len(s))
+func toUint64(s []int) []uint64 {
+	r := make([]uint64, len(s))
 	for i, v := range s {
-		c[i] = complex(float64(v), 0)
+		r[i] = uint64(v)
 	}
 
-	return c
+	return r
 }
 
-func toInt(s []complex128) []int {
+func fromUint64(s []uint64) []int {
 	r := make([]int, len(s))
 	for i, v := range s {
-		r[i] = int(math.Round(real(v)))
+		r[i] = int(v)
 	}
 
 	return r
 }
 
+// reduceInt приводит a в диапазон [0, p).
+func reduceInt(a, p int) int {
+	a %= p
+	if a < 0 {
+		a += p
+	}
+	return a
+}
+
+// modInverse находит обратный к a по модулю p расширенным алгоритмом Евклида.
+// Возвращает -1, если обратного элемента не существует.
 func modInverse(a, p int) int {
-	a = a % p
-	for x := 1; x < p; x++ {
-		if (a*x)%p == 1 {
-			return x
-		}
+	a = reduceInt(a, p)
+
+	oldR, r := a, p
+	oldS, s := 1, 0
+	for r != 0 {
+		q := oldR / r
+		oldR, r = r, oldR-q*r
+		oldS, s = s, oldS-q*s
+	}
+	if oldR != 1 {
+		return -1
+	}
+	if oldS < 0 {
+		oldS += p
 	}
-	return -1
+	return oldS
 }
 
 func tryLog(log_on bool, err error) {
@@ -282,3 +282,42 @@ func TestIsPOT(t *testing.T) {
 		}
 	})
 }
+
+func TestModInverse(t *testing.T) {
+	t.Run("inverse in a small field", func(t *testing.T) {
+		for a := 1; a < 13; a++ {
+			inv := modInverse(a, 13)
+			if a*inv%13 != 1 {
+				t.Errorf("Wrong inverse %d for %d modulo 13", inv, a)
+			}
+		}
+	})
+
+	t.Run("inverse of a negative number", func(t *testing.T) {
+		got := modInverse(-3, 7)
+		want := 2
+
+		if got != want {
+			t.Errorf("Expected %d but got %d", want, got)
+		}
+	})
+
+	t.Run("inverse modulo a large prime", func(t *testing.T) {
+		p := 2305843009213693951
+		got := modInverse(2, p)
+		want := (p + 1) / 2
+
+		if got != want {
+			t.Errorf("Expected %d but got %d", want, got)
+		}
+	})
+
+	t.Run("no inverse for non-coprime numbers", func(t *testing.T) {
+		got := modInverse(6, 9)
+		want := -1
+
+		if got != want {
+			t.Errorf("Expected %d but got %d", want, got)
+		}
+	})
+}
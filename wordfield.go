@@ -0,0 +1,317 @@
+package polygfgo
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// Reduction задаёт способ модульного приведения в WordField.
+type Reduction int
+
+const (
+	MontgomeryReduction Reduction = iota
+	BarrettReduction
+)
+
+func (r Reduction) ToString() string {
+	switch r {
+	case MontgomeryReduction:
+		return "Montgomery"
+	case BarrettReduction:
+		return "Barrett"
+	}
+	return fmt.Sprintf("Reduction(%d)", int(r))
+}
+
+// modReducer умножает вычеты по модулю p. Первый множитель предварительно
+// подготавливается через prepare (для Монтгомери - переводится в его форму),
+// после чего mul возвращает обычный вычет a*b mod p.
+type modReducer interface {
+	prepare(a uint64) uint64
+	mul(prepared, b uint64) uint64
+}
+
+// montgomeryReducer реализует умножение Монтгомери с R = 2^64 для нечётного p.
+type montgomeryReducer struct {
+	p    uint64
+	pInv uint64 // -p^(-1) mod 2^64
+	r2   uint64 // R^2 mod p
+}
+
+func newMontgomeryReducer(p uint64) montgomeryReducer {
+	// Итерации Ньютона удваивают число верных бит обратного к p по модулю 2^64
+	inv := p
+	for i := 0; i < 5; i++ {
+		inv *= 2 - p*inv
+	}
+	r := bits.Rem64(1, 0, p)
+	return montgomeryReducer{p, -inv, mulMod(r, r, p)}
+}
+
+// redc вычисляет (hi*2^64 + lo) * R^(-1) mod p при hi*2^64 + lo < p*R.
+func (m montgomeryReducer) redc(hi, lo uint64) uint64 {
+	q := lo * m.pInv
+	qh, ql := bits.Mul64(q, m.p)
+	_, carry := bits.Add64(lo, ql, 0)
+	t := hi + qh + carry
+	if t >= m.p {
+		t -= m.p
+	}
+	return t
+}
+
+func (m montgomeryReducer) prepare(a uint64) uint64 {
+	return m.redc(bits.Mul64(a, m.r2))
+}
+
+func (m montgomeryReducer) mul(prepared, b uint64) uint64 {
+	return m.redc(bits.Mul64(prepared, b))
+}
+
+// barrettReducer реализует приведение Баррета с mu = floor(4^k / p), k - битовая длина p.
+type barrettReducer struct {
+	p  uint64
+	k  uint
+	mu uint64
+}
+
+func newBarrettReducer(p uint64) barrettReducer {
+	k := uint(bits.Len64(p))
+	var mu uint64
+	if 2*k >= 64 {
+		mu, _ = bits.Div64(1<<(2*k-64), 0, p)
+	} else {
+		mu = (1 << (2 * k)) / p
+	}
+	return barrettReducer{p, k, mu}
+}
+
+func (b barrettReducer) prepare(a uint64) uint64 {
+	return a
+}
+
+func (b barrettReducer) mul(a, c uint64) uint64 {
+	hi, lo := bits.Mul64(a, c)
+
+	// q = floor(floor(x / 2^(k-1)) * mu / 2^(k+1)) отличается от floor(x / p) не более чем на 2
+	s := b.k - 1
+	shifted := lo>>s | hi<<(64-s)
+	qh, ql := bits.Mul64(shifted, b.mu)
+	q := ql>>(b.k+1) | qh<<(64-(b.k+1))
+
+	ph, pl := bits.Mul64(q, b.p)
+	rl, borrow := bits.Sub64(lo, pl, 0)
+	rh, _ := bits.Sub64(hi, ph, borrow)
+	for rh != 0 || rl >= b.p {
+		rl, borrow = bits.Sub64(rl, b.p, 0)
+		rh -= borrow
+	}
+	return rl
+}
+
+// Представление простого поля GF(p) для p < 2^63, в котором умножение выполняется
+// через 128-битные произведения math/bits с приведением Монтгомери или Баррета.
+type WordField struct {
+	p             uint64
+	reduction     Reduction
+	reducer       modReducer
+	enableLogging bool
+}
+
+// NewWordField создаёт поле GF(p) с выбранным способом приведения.
+// Приведение Монтгомери требует нечётного p.
+func NewWordField(p int, reduction Reduction, enableLogging bool) (field WordField, err error) {
+	if !IsPrime(p) {
+		err = fmt.Errorf("%w: p=%d", ErrNotPrime, p)
+		tryLog(enableLogging, err)
+		return
+	}
+
+	var reducer modReducer
+	switch reduction {
+	case MontgomeryReduction:
+		if p == 2 {
+			err = fmt.Errorf("%w: Montgomery reduction requires an odd modulus", ErrInvalidParameters)
+			tryLog(enableLogging, err)
+			return
+		}
+		reducer = newMontgomeryReducer(uint64(p))
+	case BarrettReduction:
+		reducer = newBarrettReducer(uint64(p))
+	default:
+		err = fmt.Errorf("%w: unknown reduction %s", ErrInvalidParameters, reduction.ToString())
+		tryLog(enableLogging, err)
+		return
+	}
+
+	field = WordField{uint64(p), reduction, reducer, enableLogging}
+	return
+}
+
+func (f WordField) GetPrime() int {
+	return int(f.p)
+}
+
+func (f WordField) GetDegree() int {
+	return 1
+}
+
+func (f WordField) GetIrreducible() Polynomial {
+	return newZeroPolynomial()
+}
+
+// Mul возвращает a*b mod p для вычетов a, b из [0, p).
+func (f WordField) Mul(a, b uint64) uint64 {
+	return f.reducer.mul(f.reducer.prepare(a), b)
+}
+
+func (f WordField) Normalize(poly Polynomial) Polynomial {
+	product := newPolynomialNoReverse(poly.coefs)
+	for i := 0; i < product.len; i++ {
+		product.coefs[i] = reduceInt(product.coefs[i], int(f.p))
+	}
+	return product.Normalize()
+}
+
+func (f WordField) AddPolynomials(p1, p2 Polynomial) Polynomial {
+	p1, p2 = f.Normalize(p1), f.Normalize(p2)
+	coefs := make([]int, max(p1.len, p2.len))
+	for i := range coefs {
+		coefs[i] = int(addMod(uint64(coefAt(p1, i)), uint64(coefAt(p2, i)), f.p))
+	}
+	return newPolynomialNoReverse(coefs)
+}
+
+func (f WordField) SubPolynomials(p1, p2 Polynomial) Polynomial {
+	p1, p2 = f.Normalize(p1), f.Normalize(p2)
+	coefs := make([]int, max(p1.len, p2.len))
+	for i := range coefs {
+		coefs[i] = int(subMod(uint64(coefAt(p1, i)), uint64(coefAt(p2, i)), f.p))
+	}
+	return newPolynomialNoReverse(coefs)
+}
+
+func (f WordField) MulPolynomials(p1, p2 Polynomial) Polynomial {
+	p1, p2 = f.Normalize(p1), f.Normalize(p2)
+	if p1.isZeroPolynomial() || p2.isZeroPolynomial() {
+		return newZeroPolynomial()
+	}
+
+	a, b := toUint64(p1.coefs), toUint64(p2.coefs)
+	if min(len(a), len(b)) > schoolbookThreshold {
+		return newPolynomialNoReverse(fromUint64(mulCoefs(a, b, f.p)))
+	}
+
+	result := make([]uint64, len(a)+len(b)-1)
+	for i, x := range a {
+		prepared := f.reducer.prepare(x)
+		for j, y := range b {
+			result[i+j] = addMod(result[i+j], f.reducer.mul(prepared, y), f.p)
+		}
+	}
+	return newPolynomialNoReverse(fromUint64(result))
+}
+
+func (f WordField) DivPolynomials(p1, p2 Polynomial) (quot, rem Polynomial, err error) {
+	p1, p2 = f.Normalize(p1), f.Normalize(p2)
+	if p2.isZeroPolynomial() {
+		err = fmt.Errorf("division by zero is not supported")
+		tryLog(f.enableLogging, err)
+		return newZeroPolynomial(), newZeroPolynomial(), err
+	}
+	if p1.deg < p2.deg {
+		return newZeroPolynomial(), p1, nil
+	}
+
+	inv := f.reducer.prepare(uint64(modInverse(p2.coefs[p2.deg], int(f.p))))
+	r := toUint64(p1.coefs)
+	d := toUint64(p2.coefs)
+	q := make([]uint64, p1.deg-p2.deg+1)
+	for k := p1.deg - p2.deg; k >= 0; k-- {
+		lead := f.reducer.mul(inv, r[k+p2.deg])
+		q[k] = lead
+		if lead == 0 {
+			continue
+		}
+		prepared := f.reducer.prepare(lead)
+		for i, c := range d {
+			r[k+i] = subMod(r[k+i], f.reducer.mul(prepared, c), f.p)
+		}
+	}
+
+	quot = newPolynomialNoReverse(fromUint64(q))
+	rem = newPolynomialNoReverse(fromUint64(r[:p2.deg]))
+	return
+}
+
+// InvPolynomial возвращает обратный элемент GF(p) для многочлена нулевой степени.
+func (f WordField) InvPolynomial(poly Polynomial) (Polynomial, error) {
+	poly = f.Normalize(poly)
+	if poly.deg != 0 {
+		err := fmt.Errorf("polynomial %s is not a nonzero element of %s", poly.ToString(), f.ToString())
+		tryLog(f.enableLogging, err)
+		return newZeroPolynomial(), err
+	}
+	return newPolynomialNoReverse([]int{modInverse(poly.coefs[0], int(f.p))}), nil
+}
+
+// PowModPolynomial вычисляет base^exp mod mod.
+func (f WordField) PowModPolynomial(base Polynomial, exp int, mod Polynomial) Polynomial {
+	result := newPolynomialNoReverse([]int{1})
+	_, current, _ := f.DivPolynomials(base, mod)
+
+	for exp > 0 {
+		if exp%2 == 1 {
+			_, result, _ = f.DivPolynomials(f.MulPolynomials(result, current), mod)
+		}
+		_, current, _ = f.DivPolynomials(f.MulPolynomials(current, current), mod)
+		exp /= 2
+	}
+
+	_, result, _ = f.DivPolynomials(result, mod)
+	return result
+}
+
+func (f WordField) GCD(p1, p2 Polynomial) Polynomial {
+	p1, p2 = f.Normalize(p1), f.Normalize(p2)
+	for !p2.isZeroPolynomial() {
+		_, mod, _ := f.DivPolynomials(p1, p2)
+		p1, p2 = p2, mod
+	}
+	return p1
+}
+
+// IsIrreducible проверяет, что gcd(poly, x^(p^i) - x) = 1 для всех i <= deg/2.
+// Степени x^(p^i) вычисляются последовательно, поэтому p^i не обязано помещаться в int.
+func (f WordField) IsIrreducible(poly Polynomial) bool {
+	poly = f.Normalize(poly)
+	n := poly.deg
+	if n < 1 {
+		return false
+	}
+	if n == 1 {
+		return true
+	}
+
+	x := newPolynomialNoReverse([]int{0, 1})
+	h := x
+	for i := 1; i <= n/2; i++ {
+		h = f.PowModPolynomial(h, int(f.p), poly)
+		if f.GCD(poly, f.SubPolynomials(h, x)).deg > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (f WordField) ToString() string {
+	return fmt.Sprintf("GF(%d) [%s]", f.p, f.reduction.ToString())
+}
+
+// coefAt возвращает коэффициент при x^i или 0, если i вне многочлена.
+func coefAt(poly Polynomial, i int) int {
+	if i < 0 || i >= poly.len {
+		return 0
+	}
+	return poly.coefs[i]
+}
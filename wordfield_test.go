@@ -0,0 +1,107 @@
+package polygfgo
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestWordField_Mul(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	primes := []int{3, 65537, 4294967311, 2305843009213693951, 9223372036854775783}
+
+	for _, reduction := range []Reduction{MontgomeryReduction, BarrettReduction} {
+		for _, p := range primes {
+			f, err := NewWordField(p, reduction, false)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			t.Run(f.ToString(), func(t *testing.T) {
+				for i := 0; i < 1000; i++ {
+					a, b := rng.Uint64()%uint64(p), rng.Uint64()%uint64(p)
+					if i == 0 {
+						a, b = uint64(p-1), uint64(p-1)
+					}
+
+					got := f.Mul(a, b)
+					want := mulMod(a, b, uint64(p))
+
+					if got != want {
+						t.Fatalf("Expected %d but got %d for %d * %d", want, got, a, b)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestNewWordField(t *testing.T) {
+	t.Run("composite modulus", func(t *testing.T) {
+		_, err := NewWordField(1<<62, BarrettReduction, false)
+		if !errors.Is(err, ErrNotPrime) {
+			t.Errorf("Expected %v but got %v", ErrNotPrime, err)
+		}
+	})
+
+	t.Run("Montgomery reduction with even modulus", func(t *testing.T) {
+		_, err := NewWordField(2, MontgomeryReduction, false)
+		if !errors.Is(err, ErrInvalidParameters) {
+			t.Errorf("Expected %v but got %v", ErrInvalidParameters, err)
+		}
+	})
+
+	t.Run("Barrett reduction with p = 2", func(t *testing.T) {
+		f, err := NewWordField(2, BarrettReduction, false)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got := f.Mul(1, 1); got != 1 {
+			t.Errorf("Expected 1 but got %d", got)
+		}
+	})
+}
+
+func TestWordField_Polynomials(t *testing.T) {
+	p := 9223372036854775783
+
+	for _, reduction := range []Reduction{MontgomeryReduction, BarrettReduction} {
+		f, _ := NewWordField(p, reduction, false)
+
+		t.Run("division with remainder in "+f.ToString(), func(t *testing.T) {
+			a := NewPolynomial([]int{p - 1, 123456789012345, p - 2, 7, 0, p / 3})
+			b := NewPolynomial([]int{p / 5, 1, p - 11})
+
+			quot, rem, err := f.DivPolynomials(a, b)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			got := f.AddPolynomials(f.MulPolynomials(quot, b), rem)
+
+			if !got.Equals(a) || rem.GetDegree() >= b.GetDegree() {
+				t.Errorf("Expected %s but got %s", a.ToString(), got.ToString())
+			}
+		})
+
+		t.Run("x^2 + 1 is irreducible in "+f.ToString(), func(t *testing.T) {
+			got := f.IsIrreducible(NewPolynomial([]int{1, 0, 1}))
+			want := true
+
+			if got != want {
+				t.Errorf("Expected %t but got %t", want, got)
+			}
+		})
+
+		t.Run("elements of "+f.ToString(), func(t *testing.T) {
+			a := NewElementFromInt(f, p-2)
+
+			inv, err := a.Inv()
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !a.Mul(inv).IsOne() {
+				t.Errorf("Wrong inverse %s for %s", inv.ToString(), a.ToString())
+			}
+		})
+	}
+}